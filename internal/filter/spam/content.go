@@ -0,0 +1,180 @@
+package spam
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/usememos/memos/store"
+)
+
+// ContentFilterConfig configures ContentFilter's thresholds. A nil or empty
+// BlockedPatterns/Keywords means that check is skipped.
+type ContentFilterConfig struct {
+	// BlockedPatterns are matched against memo content as regular
+	// expressions.
+	BlockedPatterns []string
+	// BlockedKeywords are matched as case-insensitive substrings.
+	BlockedKeywords []string
+	// ReviewKeywords don't block a memo outright but flag it needs_review,
+	// e.g. words that are often but not always abusive.
+	ReviewKeywords []string
+	// MaxTags caps how many tags a single memo's payload may carry. Zero
+	// means unlimited.
+	MaxTags int
+	// MaxReactionsPerMinute caps how many reactions a single memo may
+	// receive within a rolling minute. Zero means unlimited.
+	MaxReactionsPerMinute int
+}
+
+// maxIdleReactionWindows and reactionWindowIdleTTL bound reactionWindows the
+// same way maxIdleBuckets/bucketIdleTTL bound RateLimitFilter's buckets:
+// without them, reactionWindows grows one entry per distinct memo
+// ContentID ever reacted to, forever, even after that memo stops receiving
+// reactions.
+const maxIdleReactionWindows = 10000
+
+const reactionWindowIdleTTL = 10 * time.Minute
+
+// ContentFilter blocks memos and reactions against static blocklists and
+// simple per-memo thresholds.
+type ContentFilter struct {
+	patterns       []*regexp.Regexp
+	keywords       []string
+	reviewKeywords []string
+	maxTags        int
+	maxRPM         int
+
+	mu              sync.Mutex
+	reactionWindows map[string][]time.Time
+
+	now func() time.Time
+}
+
+func NewContentFilter(config ContentFilterConfig) (*ContentFilter, error) {
+	patterns := make([]*regexp.Regexp, 0, len(config.BlockedPatterns))
+	for _, raw := range config.BlockedPatterns {
+		re, err := regexp.Compile(raw)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, re)
+	}
+
+	keywords := make([]string, 0, len(config.BlockedKeywords))
+	for _, kw := range config.BlockedKeywords {
+		keywords = append(keywords, strings.ToLower(kw))
+	}
+
+	reviewKeywords := make([]string, 0, len(config.ReviewKeywords))
+	for _, kw := range config.ReviewKeywords {
+		reviewKeywords = append(reviewKeywords, strings.ToLower(kw))
+	}
+
+	return &ContentFilter{
+		patterns:        patterns,
+		keywords:        keywords,
+		reviewKeywords:  reviewKeywords,
+		maxTags:         config.MaxTags,
+		maxRPM:          config.MaxReactionsPerMinute,
+		reactionWindows: make(map[string][]time.Time),
+		now:             time.Now,
+	}, nil
+}
+
+func (f *ContentFilter) Name() string { return "content" }
+
+func (f *ContentFilter) ShouldDropReaction(_ context.Context, _ int32, reaction *store.Reaction) (bool, error) {
+	if f.maxRPM <= 0 {
+		return false, nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+	windowStart := now.Add(-time.Minute)
+	times := f.reactionWindows[reaction.ContentID]
+	kept := times[:0]
+	for _, t := range times {
+		if t.After(windowStart) {
+			kept = append(kept, t)
+		}
+	}
+	if len(kept) >= f.maxRPM {
+		f.reactionWindows[reaction.ContentID] = kept
+		return true, nil
+	}
+	f.reactionWindows[reaction.ContentID] = append(kept, now)
+	if len(f.reactionWindows) > maxIdleReactionWindows {
+		f.evictIdleReactionWindowsLocked(now)
+	}
+	return false, nil
+}
+
+// evictIdleReactionWindowsLocked drops windows that are empty or whose most
+// recent entry is older than reactionWindowIdleTTL. f.mu must already be
+// held. A window's entries are already trimmed to the last minute on every
+// access, so its last entry is also its most recent touch.
+func (f *ContentFilter) evictIdleReactionWindowsLocked(now time.Time) {
+	cutoff := now.Add(-reactionWindowIdleTTL)
+	for contentID, times := range f.reactionWindows {
+		if len(times) == 0 || times[len(times)-1].Before(cutoff) {
+			delete(f.reactionWindows, contentID)
+		}
+	}
+}
+
+func (f *ContentFilter) ShouldDropMemo(_ context.Context, _ int32, memo *store.Memo) (bool, error) {
+	if f.maxTags > 0 && memo.Payload != nil && len(memo.Payload.Tags) > f.maxTags {
+		return true, nil
+	}
+	return f.matchesBlocklist(memo.Content), nil
+}
+
+// ShouldFlagReaction never flags a reaction: a reaction carries no free text
+// of its own, only an emoji/reaction type, so there's nothing here for a
+// keyword-based filter to inspect.
+func (*ContentFilter) ShouldFlagReaction(_ context.Context, _ int32, _ *store.Reaction) (bool, error) {
+	return false, nil
+}
+
+// ShouldFlagMemo reports memos that contain a "review" keyword: one that's
+// often but not always abusive (e.g. "refund" or a brand name used in
+// impersonation attempts), so it's routed to a moderator instead of being
+// blocked outright.
+func (f *ContentFilter) ShouldFlagMemo(_ context.Context, _ int32, memo *store.Memo) (bool, error) {
+	lower := strings.ToLower(memo.Content)
+	for _, kw := range f.reviewKeywords {
+		if strings.Contains(lower, kw) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Reset discards all tracked reaction windows, returning the filter to its
+// freshly-constructed state. Intended for tests that share a single filter
+// instance across cases and need each case to start with a clean slate.
+func (f *ContentFilter) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reactionWindows = make(map[string][]time.Time)
+}
+
+func (f *ContentFilter) matchesBlocklist(content string) bool {
+	lower := strings.ToLower(content)
+	for _, kw := range f.keywords {
+		if strings.Contains(lower, kw) {
+			return true
+		}
+	}
+	for _, re := range f.patterns {
+		if re.MatchString(content) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package spam
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/usememos/memos/store"
+)
+
+// maxIdleBuckets bounds how many per-user buckets RateLimitFilter keeps
+// before it sweeps out the ones that are both idle (not touched in
+// bucketIdleTTL) and full (so evicting them changes nothing observable: the
+// next check for that user recreates an identical bucket from scratch).
+// Without this, a long-running server accumulates one bucket per distinct
+// (action, userID) pair forever.
+const maxIdleBuckets = 10000
+
+const bucketIdleTTL = 10 * time.Minute
+
+// RateLimitFilter enforces a token-bucket rate limit per user ID, with a
+// separate bucket configuration per action ("reaction", "memo").
+type RateLimitFilter struct {
+	mu      sync.Mutex
+	buckets map[rateLimitKey]*tokenBucket
+	limits  map[string]rateLimitConfig
+
+	now func() time.Time
+}
+
+type rateLimitKey struct {
+	action string
+	userID int32
+}
+
+type rateLimitConfig struct {
+	capacity   int
+	refillRate time.Duration // time to refill one token
+}
+
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// RateLimitConfig configures a single action's bucket, e.g.
+// {Action: "reaction", Capacity: 30, RefillRate: time.Second}
+// allows bursts of up to 30 reactions, refilling one token per second.
+type RateLimitConfig struct {
+	Action     string
+	Capacity   int
+	RefillRate time.Duration
+}
+
+func NewRateLimitFilter(configs ...RateLimitConfig) *RateLimitFilter {
+	limits := make(map[string]rateLimitConfig, len(configs))
+	for _, c := range configs {
+		limits[c.Action] = rateLimitConfig{capacity: c.Capacity, refillRate: c.RefillRate}
+	}
+	return &RateLimitFilter{
+		buckets: make(map[rateLimitKey]*tokenBucket),
+		limits:  limits,
+		now:     time.Now,
+	}
+}
+
+func (f *RateLimitFilter) Name() string { return "rate-limit" }
+
+func (f *RateLimitFilter) ShouldDropReaction(_ context.Context, userID int32, _ *store.Reaction) (bool, error) {
+	return f.exhausted("reaction", userID), nil
+}
+
+func (*RateLimitFilter) ShouldFlagReaction(context.Context, int32, *store.Reaction) (bool, error) {
+	// Rate limiting only ever drops; there's nothing about exceeding a rate
+	// that warrants a human review rather than a plain retry.
+	return false, nil
+}
+
+func (f *RateLimitFilter) ShouldDropMemo(_ context.Context, userID int32, _ *store.Memo) (bool, error) {
+	return f.exhausted("memo", userID), nil
+}
+
+func (*RateLimitFilter) ShouldFlagMemo(context.Context, int32, *store.Memo) (bool, error) {
+	return false, nil
+}
+
+// exhausted reports whether action has a configured limit and userID has no
+// tokens left for it, consuming one token if not.
+func (f *RateLimitFilter) exhausted(action string, userID int32) bool {
+	config, ok := f.limits[action]
+	if !ok {
+		return false
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := rateLimitKey{action: action, userID: userID}
+	bucket, ok := f.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(config.capacity), lastRefill: f.now()}
+		f.buckets[key] = bucket
+	}
+
+	elapsed := f.now().Sub(bucket.lastRefill)
+	if config.refillRate > 0 {
+		refilled := float64(elapsed) / float64(config.refillRate)
+		bucket.tokens = minFloat(float64(config.capacity), bucket.tokens+refilled)
+	}
+	bucket.lastRefill = f.now()
+
+	if bucket.tokens < 1 {
+		return true
+	}
+	bucket.tokens--
+
+	if len(f.buckets) > maxIdleBuckets {
+		f.evictIdleLocked()
+	}
+	return false
+}
+
+// evictIdleLocked drops buckets that are both full and haven't been touched
+// in bucketIdleTTL. f.mu must already be held.
+func (f *RateLimitFilter) evictIdleLocked() {
+	now := f.now()
+	for key, bucket := range f.buckets {
+		config, ok := f.limits[key.action]
+		if !ok {
+			continue
+		}
+		if bucket.tokens >= float64(config.capacity) && now.Sub(bucket.lastRefill) > bucketIdleTTL {
+			delete(f.buckets, key)
+		}
+	}
+}
+
+// Reset discards all tracked buckets, returning the filter to its
+// freshly-constructed state. Intended for tests that share a single filter
+// instance across cases and need each case to start with a clean slate.
+func (f *RateLimitFilter) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.buckets = make(map[rateLimitKey]*tokenBucket)
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
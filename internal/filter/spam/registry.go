@@ -0,0 +1,47 @@
+package spam
+
+import "sync"
+
+// Factory builds a Filter from its raw configuration. Operators register
+// their own filters under a name at init time and reference that name from
+// config, the same way the store package's driver registry works.
+type Factory func(config map[string]any) (Filter, error)
+
+var (
+	registryMu sync.Mutex
+	registry   = map[string]Factory{}
+)
+
+// Register makes a filter factory available under name. It panics on a
+// duplicate registration, matching the pattern used by database/sql
+// drivers: a programming error, not a runtime condition to recover from.
+func Register(name string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[name]; ok {
+		panic("spam: filter " + name + " already registered")
+	}
+	registry[name] = factory
+}
+
+// Build looks up the factory registered under name and invokes it with
+// config.
+func Build(name string, config map[string]any) (Filter, error) {
+	registryMu.Lock()
+	factory, ok := registry[name]
+	registryMu.Unlock()
+	if !ok {
+		return nil, ErrUnknownFilter{Name: name}
+	}
+	return factory(config)
+}
+
+// ErrUnknownFilter is returned by Build when name has no registered
+// factory.
+type ErrUnknownFilter struct {
+	Name string
+}
+
+func (e ErrUnknownFilter) Error() string {
+	return "spam: no filter registered under name " + e.Name
+}
@@ -0,0 +1,100 @@
+// Package spam implements a pluggable pipeline of abuse filters that the
+// reaction and memo create/update paths run content through before it is
+// persisted.
+package spam
+
+import (
+	"context"
+
+	"github.com/usememos/memos/store"
+)
+
+// Filter is implemented by each stage of the pipeline. Reaction and Memo are
+// the two content shapes filters currently see; a filter that doesn't care
+// about one of them should simply return false, nil for it.
+type Filter interface {
+	// Name identifies the filter in logs and in FlaggedItem rows.
+	Name() string
+	ShouldDropReaction(ctx context.Context, userID int32, reaction *store.Reaction) (bool, error)
+	ShouldFlagReaction(ctx context.Context, userID int32, reaction *store.Reaction) (bool, error)
+	ShouldDropMemo(ctx context.Context, userID int32, memo *store.Memo) (bool, error)
+	ShouldFlagMemo(ctx context.Context, userID int32, memo *store.Memo) (bool, error)
+}
+
+// Pipeline runs a sequence of filters in order, stopping at the first one
+// that wants to drop the content.
+type Pipeline struct {
+	filters []Filter
+}
+
+func NewPipeline(filters ...Filter) *Pipeline {
+	return &Pipeline{filters: filters}
+}
+
+// resettable is implemented by filters that hold per-user state (e.g.
+// RateLimitFilter's buckets, ContentFilter's reaction windows) and can clear
+// it. Filters with no such state simply don't implement it.
+type resettable interface {
+	Reset()
+}
+
+// Reset clears any per-user state held by the pipeline's filters. Intended
+// for tests that share a single pipeline across cases and need each case to
+// start with a clean slate.
+func (p *Pipeline) Reset() {
+	for _, filter := range p.filters {
+		if r, ok := filter.(resettable); ok {
+			r.Reset()
+		}
+	}
+}
+
+// CheckReaction runs every filter's drop and flag checks for a reaction.
+// Drop takes precedence: a reaction a filter wants dropped is never also
+// considered for flagging.
+func (p *Pipeline) CheckReaction(ctx context.Context, userID int32, reaction *store.Reaction) (drop bool, flag bool, by string, err error) {
+	for _, filter := range p.filters {
+		ok, err := filter.ShouldDropReaction(ctx, userID, reaction)
+		if err != nil {
+			return false, false, "", err
+		}
+		if ok {
+			return true, false, filter.Name(), nil
+		}
+	}
+	for _, filter := range p.filters {
+		ok, err := filter.ShouldFlagReaction(ctx, userID, reaction)
+		if err != nil {
+			return false, false, "", err
+		}
+		if ok {
+			return false, true, filter.Name(), nil
+		}
+	}
+	return false, false, "", nil
+}
+
+// CheckMemo runs every filter's drop and flag checks for a memo create or
+// update. Drop takes precedence: a memo a filter wants dropped is never
+// also considered for flagging.
+func (p *Pipeline) CheckMemo(ctx context.Context, userID int32, memo *store.Memo) (drop bool, flag bool, by string, err error) {
+	for _, filter := range p.filters {
+		ok, err := filter.ShouldDropMemo(ctx, userID, memo)
+		if err != nil {
+			return false, false, "", err
+		}
+		if ok {
+			return true, false, filter.Name(), nil
+		}
+	}
+	for _, filter := range p.filters {
+		ok, err := filter.ShouldFlagMemo(ctx, userID, memo)
+		if err != nil {
+			return false, false, "", err
+		}
+		if ok {
+			return false, true, filter.Name(), nil
+		}
+	}
+	return false, false, "", nil
+}
@@ -0,0 +1,137 @@
+// Package tag implements the pure string-rewriting logic behind the v1
+// API's hierarchical tag rename/move RPCs (see
+// server/router/api/v1/tag_rename_service.go): renaming a tag's entries in
+// a memo's Payload.Tags list and rewriting the matching "#tag" references
+// in its markdown content. Nothing here touches the store; callers are
+// responsible for finding the affected memos and persisting the result.
+package tag
+
+import (
+	"regexp"
+	"strings"
+)
+
+// tagTokenPattern matches a markdown tag reference: a "#" preceded by
+// start-of-line or whitespace, followed by a run of anything that isn't
+// whitespace or one of the punctuation marks prose commonly uses to end a
+// tag mention. Excluding characters rather than allow-listing a Unicode
+// category means CJK text and emoji (including multi-codepoint emoji
+// built from a base rune plus a variation selector) count as ordinary tag
+// characters without needing to special-case them. Capturing the whole
+// contiguous run is also what lets matching stop at a word boundary on
+// its own: renaming "old" will capture "oldfoo" as a single token that
+// simply doesn't equal "old" or start with "old/", so it's left alone
+// without any extra boundary bookkeeping.
+var tagTokenPattern = regexp.MustCompile("(^|\\s)#([^\\s#,;:!?()\\[\\]{}<>\"'`]+)")
+
+// MatchesOrIsChild reports whether candidate is target itself or a tag
+// nested under it, e.g. "work/sub" is a child of "work" but "workshop" is
+// not.
+func MatchesOrIsChild(candidate, target string) bool {
+	return candidate == target || strings.HasPrefix(candidate, target+"/")
+}
+
+// Rename rewrites a single tag value: candidate itself becomes
+// replacement, and a child tag keeps its suffix intact, e.g. renaming
+// "work" to "job" turns "work/sub" into "job/sub". ok is false when
+// candidate doesn't match target or one of its children, in which case
+// candidate is returned unchanged.
+func Rename(candidate, target, replacement string) (renamed string, ok bool) {
+	if !MatchesOrIsChild(candidate, target) {
+		return candidate, false
+	}
+	return replacement + candidate[len(target):], true
+}
+
+// RenameList rewrites every tag in tags that matches target or one of its
+// children, preserving order and leaving every other entry's bytes
+// (emoji, variation selectors included) untouched. If a rename produces a
+// value that's already present -- e.g. a memo carrying both "work" and
+// "job" renaming "work" to "job" -- the resulting duplicate is dropped
+// rather than left in the list twice. changed reports whether anything in
+// the slice was touched, including when the only effect was dropping a
+// duplicate.
+func RenameList(tags []string, target, replacement string) (renamed []string, changed bool) {
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		next, ok := Rename(t, target, replacement)
+		changed = changed || ok
+		if seen[next] {
+			changed = true
+			continue
+		}
+		seen[next] = true
+		renamed = append(renamed, next)
+	}
+	return renamed, changed
+}
+
+// RenameInContent rewrites every "#target" and "#target/child" reference
+// in markdown content, leaving fenced code blocks (``` or ~~~) and inline
+// code spans (`...`) untouched. changed reports whether anything was
+// rewritten.
+func RenameInContent(content, target, replacement string) (rewritten string, changed bool) {
+	lines := strings.Split(content, "\n")
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		next, lineChanged := renameInLine(line, target, replacement)
+		if lineChanged {
+			lines[i] = next
+			changed = true
+		}
+	}
+	if !changed {
+		return content, false
+	}
+	return strings.Join(lines, "\n"), true
+}
+
+// renameInLine rewrites tag references outside of inline code spans. A
+// line with an odd number of backticks (an unterminated span) is left
+// entirely untouched rather than risk rewriting inside it.
+func renameInLine(line, target, replacement string) (string, bool) {
+	segments := strings.Split(line, "`")
+	if len(segments)%2 == 0 {
+		return line, false
+	}
+
+	changed := false
+	for i, segment := range segments {
+		// Even indexes are outside backticks; odd indexes are inline code.
+		if i%2 != 0 {
+			continue
+		}
+		next, segmentChanged := renameTokens(segment, target, replacement)
+		if segmentChanged {
+			segments[i] = next
+			changed = true
+		}
+	}
+	if !changed {
+		return line, false
+	}
+	return strings.Join(segments, "`"), true
+}
+
+func renameTokens(text, target, replacement string) (string, bool) {
+	changed := false
+	result := tagTokenPattern.ReplaceAllStringFunc(text, func(match string) string {
+		groups := tagTokenPattern.FindStringSubmatch(match)
+		lead, candidate := groups[1], groups[2]
+		next, ok := Rename(candidate, target, replacement)
+		if !ok {
+			return match
+		}
+		changed = true
+		return lead + "#" + next
+	})
+	return result, changed
+}
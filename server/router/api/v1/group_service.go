@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// GroupNamePrefix matches the "groups/{id}" resource-name convention used
+// throughout the rest of the v1 API (see UserNamePrefix, MemoNamePrefix).
+const GroupNamePrefix = "groups/"
+
+func (s *APIV1Service) CreateGroup(ctx context.Context, request *v1pb.CreateGroupRequest) (*v1pb.Group, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil || !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "only admins may create groups")
+	}
+	if request.Group.GetDisplayName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "group display name is required")
+	}
+
+	group, err := s.Store.CreateGroup(ctx, &store.Group{
+		Name:      request.Group.DisplayName,
+		CreatorID: user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+	}
+	return convertGroupFromStore(group), nil
+}
+
+func (s *APIV1Service) ListGroups(ctx context.Context, _ *v1pb.ListGroupsRequest) (*v1pb.ListGroupsResponse, error) {
+	groups, err := s.Store.ListGroups(ctx, &store.FindGroup{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+	response := &v1pb.ListGroupsResponse{Groups: []*v1pb.Group{}}
+	for _, group := range groups {
+		response.Groups = append(response.Groups, convertGroupFromStore(group))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) DeleteGroup(ctx context.Context, request *v1pb.DeleteGroupRequest) (*emptypb.Empty, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil || !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "only admins may delete groups")
+	}
+
+	groupID, err := ExtractGroupIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group name: %v", err)
+	}
+	if err := s.Store.DeleteGroup(ctx, &store.DeleteGroup{ID: groupID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete group: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UpsertGroupMember adds user to the group, creating the membership row if
+// it doesn't already exist.
+func (s *APIV1Service) UpsertGroupMember(ctx context.Context, request *v1pb.UpsertGroupMemberRequest) (*emptypb.Empty, error) {
+	actor, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if actor == nil || !isSuperUser(actor) {
+		return nil, status.Errorf(codes.PermissionDenied, "only admins may manage group membership")
+	}
+
+	groupID, err := ExtractGroupIDFromName(request.Group)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group name: %v", err)
+	}
+	userID, err := ExtractUserIDFromName(request.Member)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid member name: %v", err)
+	}
+
+	if err := s.Store.UpsertGroupMember(ctx, &store.GroupMember{GroupID: groupID, UserID: userID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add group member: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) DeleteGroupMember(ctx context.Context, request *v1pb.DeleteGroupMemberRequest) (*emptypb.Empty, error) {
+	actor, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if actor == nil || !isSuperUser(actor) {
+		return nil, status.Errorf(codes.PermissionDenied, "only admins may manage group membership")
+	}
+
+	groupID, err := ExtractGroupIDFromName(request.Group)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group name: %v", err)
+	}
+	userID, err := ExtractUserIDFromName(request.Member)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid member name: %v", err)
+	}
+
+	if err := s.Store.DeleteGroupMember(ctx, &store.GroupMember{GroupID: groupID, UserID: userID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove group member: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ExtractGroupIDFromName parses the numeric ID out of a "groups/{id}"
+// resource name.
+func ExtractGroupIDFromName(name string) (int32, error) {
+	var id int32
+	if _, err := fmt.Sscanf(name, GroupNamePrefix+"%d", &id); err != nil {
+		return 0, fmt.Errorf("invalid group name %q: %w", name, err)
+	}
+	return id, nil
+}
+
+func convertGroupFromStore(group *store.Group) *v1pb.Group {
+	return &v1pb.Group{
+		Name:        fmt.Sprintf("%s%d", GroupNamePrefix, group.ID),
+		DisplayName: group.Name,
+		CreateTime:  timestamppb.New(time.Unix(group.CreatedTs, 0)),
+	}
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// UpdateMemoAcl replaces the full set of ACL entries on a memo: anything
+// not present in request.Entries after this call is removed. Only the
+// memo's creator or an admin may grant or revoke access this way.
+func (s *APIV1Service) UpdateMemoAcl(ctx context.Context, request *v1pb.UpdateMemoAclRequest) (*v1pb.UpdateMemoAclResponse, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "only the memo's creator or an admin may update its ACL")
+	}
+
+	existing, err := s.Store.ListMemoACL(ctx, &store.FindMemoACL{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list existing ACL: %v", err)
+	}
+	keep := make(map[string]bool, len(request.Entries))
+	for _, entry := range request.Entries {
+		keep[entry.Subject+"|"+entry.Role.String()] = true
+	}
+	for _, entry := range existing {
+		if keep[entry.Subject+"|"+convertMemoRoleFromStore(entry.Role).String()] {
+			continue
+		}
+		if err := s.Store.DeleteMemoACLEntry(ctx, entry); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to remove stale ACL entry: %v", err)
+		}
+	}
+
+	response := &v1pb.UpdateMemoAclResponse{}
+	for _, entry := range request.Entries {
+		role, err := convertMemoRoleToStore(entry.Role)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "entry for %q: %v", entry.Subject, err)
+		}
+		if err := s.Store.UpsertMemoACLEntry(ctx, &store.MemoACLEntry{
+			MemoID:  memo.ID,
+			Subject: entry.Subject,
+			Role:    role,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to upsert ACL entry: %v", err)
+		}
+		response.Entries = append(response.Entries, &v1pb.MemoAclEntry{Subject: entry.Subject, Role: entry.Role})
+	}
+	return response, nil
+}
+
+// convertMemoRoleToStore rejects v1pb.MemoRole_MEMO_ROLE_UNSPECIFIED rather
+// than defaulting it to store.MemoRoleReader: a request that omits role
+// (e.g. a client bug dropping the field) should fail loudly instead of
+// silently granting read access.
+func convertMemoRoleToStore(role v1pb.MemoRole) (store.MemoRole, error) {
+	switch role {
+	case v1pb.MemoRole_READER:
+		return store.MemoRoleReader, nil
+	case v1pb.MemoRole_COMMENTER:
+		return store.MemoRoleCommenter, nil
+	case v1pb.MemoRole_REACTOR:
+		return store.MemoRoleReactor, nil
+	default:
+		return "", fmt.Errorf("unsupported or unspecified memo role %q", role)
+	}
+}
+
+func convertMemoRoleFromStore(role store.MemoRole) v1pb.MemoRole {
+	switch role {
+	case store.MemoRoleCommenter:
+		return v1pb.MemoRole_COMMENTER
+	case store.MemoRoleReactor:
+		return v1pb.MemoRole_REACTOR
+	default:
+		return v1pb.MemoRole_READER
+	}
+}
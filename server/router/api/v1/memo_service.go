@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// CreateMemo persists a new memo, running its content through the spam
+// filter pipeline (see internal/filter/spam) before it's written, the same
+// way UpsertMemoReaction does for reactions.
+func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoRequest) (*v1pb.Memo, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if request.Memo == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "memo is required")
+	}
+
+	candidate := &store.Memo{
+		UID:        genMemoUID(),
+		CreatorID:  user.ID,
+		Content:    request.Memo.Content,
+		Visibility: convertVisibilityToStore(request.Memo.Visibility),
+	}
+
+	drop, flag, filterName, err := s.checkMemoAgainstSpamPipeline(ctx, user.ID, candidate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to run spam filters: %v", err)
+	}
+	if drop {
+		return nil, resourceExhaustedWithRetry(
+			fmt.Sprintf("memo rejected by the %s filter, please retry later", filterName),
+			memoSpamRetryAfter,
+		)
+	}
+
+	memo, err := s.Store.CreateMemo(ctx, candidate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo: %v", err)
+	}
+	if flag {
+		s.recordFlaggedMemo(ctx, memo.ID, filterName)
+	}
+
+	return s.convertMemoFromStore(ctx, memo, nil, nil)
+}
+
+// UpdateMemo rewrites an existing memo's content. Only the memo's creator
+// or an admin may call this, the same gating UpdateMemoAcl uses. The
+// rewritten content runs through the spam filter pipeline before it's
+// written, the same as CreateMemo.
+func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoRequest) (*v1pb.Memo, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if request.Memo == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "memo is required")
+	}
+
+	memoUID, err := ExtractMemoUIDFromName(request.Memo.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "only the memo's creator or an admin may update it")
+	}
+
+	candidate := &store.Memo{
+		ID:         memo.ID,
+		UID:        memo.UID,
+		CreatorID:  memo.CreatorID,
+		Content:    request.Memo.Content,
+		Visibility: convertVisibilityToStore(request.Memo.Visibility),
+		Payload:    memo.Payload,
+	}
+
+	drop, flag, filterName, err := s.checkMemoAgainstSpamPipeline(ctx, user.ID, candidate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to run spam filters: %v", err)
+	}
+	if drop {
+		return nil, resourceExhaustedWithRetry(
+			fmt.Sprintf("memo rejected by the %s filter, please retry later", filterName),
+			memoSpamRetryAfter,
+		)
+	}
+
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:         memo.ID,
+		Content:    &candidate.Content,
+		Visibility: &candidate.Visibility,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+	}
+	if flag {
+		s.recordFlaggedMemo(ctx, memo.ID, filterName)
+	}
+
+	memo.Content = candidate.Content
+	memo.Visibility = candidate.Visibility
+	return s.convertMemoFromStore(ctx, memo, nil, nil)
+}
+
+// convertVisibilityToStore defaults an unspecified visibility to
+// store.Private, the most restrictive option: unlike convertMemoRoleToStore
+// rejecting an unspecified MemoRole (where defaulting would silently grant
+// access), defaulting here only ever narrows what a dropped field would
+// have requested.
+func convertVisibilityToStore(visibility v1pb.Visibility) store.Visibility {
+	switch visibility {
+	case v1pb.Visibility_PROTECTED:
+		return store.Protected
+	case v1pb.Visibility_PUBLIC:
+		return store.Public
+	default:
+		return store.Private
+	}
+}
+
+// genMemoUID generates the random identifier used in a memo's resource
+// name, e.g. "memos/<genMemoUID()>".
+func genMemoUID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand.Read only fails if the OS RNG is unavailable, in
+		// which case nothing else on the box would work either.
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
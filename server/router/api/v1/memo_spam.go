@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/usememos/memos/store"
+)
+
+// checkMemoAgainstSpamPipeline runs memo through defaultSpamPipeline,
+// mirroring how UpsertMemoReaction checks reactions. filterName is only
+// meaningful when drop or flag is true.
+//
+// It's called from CreateMemo and UpdateMemo before the memo is persisted.
+// Unlike the reaction path, it can't also record the FlaggedItem here: memo
+// doesn't have its ID assigned yet on create. Callers record one via
+// recordFlaggedMemo once the memo (and its ID) exists.
+func (s *APIV1Service) checkMemoAgainstSpamPipeline(ctx context.Context, userID int32, memo *store.Memo) (drop bool, flag bool, filterName string, err error) {
+	return defaultSpamPipeline.CheckMemo(ctx, userID, memo)
+}
+
+// recordFlaggedMemo records a FlaggedItem for a memo a filter flagged
+// rather than dropped. Called after checkMemoAgainstSpamPipeline reports
+// flag, once the memo has been persisted and its ID is known.
+func (s *APIV1Service) recordFlaggedMemo(ctx context.Context, memoID int32, filterName string) {
+	if _, err := s.Store.CreateFlaggedItem(ctx, &store.FlaggedItem{
+		ItemType:   store.FlaggedItemTypeMemo,
+		ItemID:     memoID,
+		FilterName: filterName,
+	}); err != nil {
+		slog.Warn("Failed to record flagged memo", slog.Any("err", err))
+	}
+}
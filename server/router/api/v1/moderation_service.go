@@ -0,0 +1,275 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// FlaggedContentNamePrefix matches the "flaggedContent/{id}" resource-name
+// convention used throughout the rest of the v1 API (see GroupNamePrefix,
+// UserNamePrefix).
+const FlaggedContentNamePrefix = "flaggedContent/"
+
+// ListFlaggedContent returns the moderation queue the spam pipeline (see
+// internal/filter/spam) populates. It defaults to the NEEDS_REVIEW status
+// so the common "show me the queue" call doesn't need to pass anything.
+func (s *APIV1Service) ListFlaggedContent(ctx context.Context, request *v1pb.ListFlaggedContentRequest) (*v1pb.ListFlaggedContentResponse, error) {
+	if err := s.requireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	find := &store.FindFlaggedItem{}
+	if request.ItemType != v1pb.FlaggedContentType_FLAGGED_CONTENT_TYPE_UNSPECIFIED {
+		itemType := convertFlaggedItemTypeToStore(request.ItemType)
+		find.ItemType = &itemType
+	}
+	itemStatus := store.FlaggedItemStatusNeedsReview
+	if request.Status != v1pb.FlaggedContentStatus_FLAGGED_CONTENT_STATUS_UNSPECIFIED {
+		itemStatus = convertFlaggedItemStatusToStore(request.Status)
+	}
+	find.Status = &itemStatus
+
+	items, err := s.Store.ListFlaggedItems(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list flagged content: %v", err)
+	}
+
+	response := &v1pb.ListFlaggedContentResponse{FlaggedContent: []*v1pb.FlaggedContent{}}
+	for _, item := range items {
+		flagged, err := s.convertFlaggedItemFromStore(ctx, item)
+		if err != nil {
+			// The memo or reaction an item pointed at may since have been
+			// deleted outside the purge flow (e.g. by its owner); skip it
+			// rather than failing the whole list.
+			continue
+		}
+		response.FlaggedContent = append(response.FlaggedContent, flagged)
+	}
+	return response, nil
+}
+
+// ResolveFlaggedContent marks the named rows reviewed. For a flagged
+// reaction this also clears the reaction's NeedsReview flag: resolving
+// means a moderator reviewed it and chose to keep it (see
+// store.FlaggedItemStatusResolved's doc comment), so it should stop being
+// hidden from non-owners the way ListMemoReactions hides any reaction
+// still needing review.
+func (s *APIV1Service) ResolveFlaggedContent(ctx context.Context, request *v1pb.ResolveFlaggedContentRequest) (*emptypb.Empty, error) {
+	if err := s.requireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, name := range request.Names {
+		id, err := ExtractFlaggedContentIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid flagged content name: %v", err)
+		}
+		items, err := s.Store.ListFlaggedItems(ctx, &store.FindFlaggedItem{ID: &id})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to look up flagged content: %v", err)
+		}
+		if len(items) == 0 {
+			return nil, status.Errorf(codes.NotFound, "flagged content %q not found", name)
+		}
+		if err := s.clearReactionNeedsReview(ctx, items[0]); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to resolve flagged content: %v", err)
+		}
+		if _, err := s.Store.UpdateFlaggedItem(ctx, &store.UpdateFlaggedItem{
+			ID:     id,
+			Status: store.FlaggedItemStatusResolved,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to resolve flagged content: %v", err)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// clearReactionNeedsReview clears NeedsReview on the reaction item points
+// at. A flagged memo has no NeedsReview flag of its own to clear; its
+// FlaggedItem row's Status is the only state to update, which the caller
+// already does.
+func (s *APIV1Service) clearReactionNeedsReview(ctx context.Context, item *store.FlaggedItem) error {
+	if item.ItemType != store.FlaggedItemTypeReaction {
+		return nil
+	}
+	reaction, err := s.Store.GetReaction(ctx, &store.FindReaction{ID: &item.ItemID})
+	if err != nil {
+		return err
+	}
+	if reaction == nil || !reaction.NeedsReview {
+		return nil
+	}
+	_, err = s.Store.UpsertReaction(ctx, &store.Reaction{
+		ID:             reaction.ID,
+		CreatorID:      reaction.CreatorID,
+		ContentID:      reaction.ContentID,
+		ReactionType:   reaction.ReactionType,
+		ApprovalStatus: reaction.ApprovalStatus,
+		NeedsReview:    false,
+	})
+	return err
+}
+
+// PurgeFlaggedContent marks the named rows purged and deletes the
+// underlying memo or reaction each one points at.
+func (s *APIV1Service) PurgeFlaggedContent(ctx context.Context, request *v1pb.PurgeFlaggedContentRequest) (*emptypb.Empty, error) {
+	if err := s.requireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, name := range request.Names {
+		id, err := ExtractFlaggedContentIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid flagged content name: %v", err)
+		}
+		item, err := s.Store.ListFlaggedItems(ctx, &store.FindFlaggedItem{ID: &id})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to look up flagged content: %v", err)
+		}
+		if len(item) == 0 {
+			return nil, status.Errorf(codes.NotFound, "flagged content %q not found", name)
+		}
+
+		if err := s.purgeFlaggedItem(ctx, item[0]); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to purge flagged content: %v", err)
+		}
+		if _, err := s.Store.UpdateFlaggedItem(ctx, &store.UpdateFlaggedItem{
+			ID:     id,
+			Status: store.FlaggedItemStatusPurged,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to purge flagged content: %v", err)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// purgeFlaggedItem deletes the memo or reaction a flagged item points at.
+// A item whose target is already gone is treated as already purged.
+func (s *APIV1Service) purgeFlaggedItem(ctx context.Context, item *store.FlaggedItem) error {
+	switch item.ItemType {
+	case store.FlaggedItemTypeMemo:
+		return s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: item.ItemID})
+	case store.FlaggedItemTypeReaction:
+		return s.Store.DeleteReaction(ctx, &store.DeleteReaction{ID: item.ItemID})
+	default:
+		return status.Errorf(codes.Internal, "unknown flagged item type %q", item.ItemType)
+	}
+}
+
+// requireModerator returns a PermissionDenied error unless the current
+// user is a host/admin. Moderation follows the same admin-only gating as
+// group management (see CreateGroup).
+func (s *APIV1Service) requireModerator(ctx context.Context) error {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil || !isSuperUser(user) {
+		return status.Errorf(codes.PermissionDenied, "only admins may review flagged content")
+	}
+	return nil
+}
+
+// convertFlaggedItemFromStore resolves item's underlying memo or reaction
+// to build the resource name moderators act on.
+func (s *APIV1Service) convertFlaggedItemFromStore(ctx context.Context, item *store.FlaggedItem) (*v1pb.FlaggedContent, error) {
+	var itemName string
+	switch item.ItemType {
+	case store.FlaggedItemTypeMemo:
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &item.ItemID})
+		if err != nil {
+			return nil, err
+		}
+		if memo == nil {
+			return nil, fmt.Errorf("memo %d not found", item.ItemID)
+		}
+		itemName = fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	case store.FlaggedItemTypeReaction:
+		reaction, err := s.Store.GetReaction(ctx, &store.FindReaction{ID: &item.ItemID})
+		if err != nil {
+			return nil, err
+		}
+		if reaction == nil {
+			return nil, fmt.Errorf("reaction %d not found", item.ItemID)
+		}
+		itemName = fmt.Sprintf("%s/%s%d", reaction.ContentID, ReactionNamePrefix, reaction.ID)
+	default:
+		return nil, fmt.Errorf("unknown flagged item type %q", item.ItemType)
+	}
+
+	return &v1pb.FlaggedContent{
+		Name:       fmt.Sprintf("%s%d", FlaggedContentNamePrefix, item.ID),
+		ItemType:   convertFlaggedItemTypeFromStore(item.ItemType),
+		Item:       itemName,
+		FilterName: item.FilterName,
+		Status:     convertFlaggedItemStatusFromStore(item.Status),
+		CreateTime: timestamppb.New(time.Unix(item.CreatedTs, 0)),
+	}, nil
+}
+
+func convertFlaggedItemTypeFromStore(itemType store.FlaggedItemType) v1pb.FlaggedContentType {
+	switch itemType {
+	case store.FlaggedItemTypeMemo:
+		return v1pb.FlaggedContentType_MEMO
+	case store.FlaggedItemTypeReaction:
+		return v1pb.FlaggedContentType_REACTION
+	default:
+		return v1pb.FlaggedContentType_FLAGGED_CONTENT_TYPE_UNSPECIFIED
+	}
+}
+
+func convertFlaggedItemTypeToStore(itemType v1pb.FlaggedContentType) store.FlaggedItemType {
+	switch itemType {
+	case v1pb.FlaggedContentType_MEMO:
+		return store.FlaggedItemTypeMemo
+	case v1pb.FlaggedContentType_REACTION:
+		return store.FlaggedItemTypeReaction
+	default:
+		return ""
+	}
+}
+
+func convertFlaggedItemStatusFromStore(itemStatus store.FlaggedItemStatus) v1pb.FlaggedContentStatus {
+	switch itemStatus {
+	case store.FlaggedItemStatusNeedsReview:
+		return v1pb.FlaggedContentStatus_NEEDS_REVIEW
+	case store.FlaggedItemStatusResolved:
+		return v1pb.FlaggedContentStatus_RESOLVED
+	case store.FlaggedItemStatusPurged:
+		return v1pb.FlaggedContentStatus_PURGED
+	default:
+		return v1pb.FlaggedContentStatus_FLAGGED_CONTENT_STATUS_UNSPECIFIED
+	}
+}
+
+func convertFlaggedItemStatusToStore(itemStatus v1pb.FlaggedContentStatus) store.FlaggedItemStatus {
+	switch itemStatus {
+	case v1pb.FlaggedContentStatus_NEEDS_REVIEW:
+		return store.FlaggedItemStatusNeedsReview
+	case v1pb.FlaggedContentStatus_RESOLVED:
+		return store.FlaggedItemStatusResolved
+	case v1pb.FlaggedContentStatus_PURGED:
+		return store.FlaggedItemStatusPurged
+	default:
+		return ""
+	}
+}
+
+// ExtractFlaggedContentIDFromName parses the numeric ID out of a
+// "flaggedContent/{id}" resource name.
+func ExtractFlaggedContentIDFromName(name string) (int32, error) {
+	var id int32
+	if _, err := fmt.Sscanf(name, FlaggedContentNamePrefix+"%d", &id); err != nil {
+		return 0, fmt.Errorf("invalid flagged content name %q: %w", name, err)
+	}
+	return id, nil
+}
@@ -23,10 +23,42 @@ func (s *APIV1Service) ListMemoReactions(ctx context.Context, request *v1pb.List
 		return nil, status.Errorf(codes.Internal, "failed to list reactions")
 	}
 
+	// Only the memo owner may see reactions still awaiting approval; other
+	// viewers (including anonymous ones) only ever see accepted reactions.
+	// A viewer who cannot even read the memo (e.g. a Private memo not
+	// shared with them via ACL) cannot list its reactions at all.
+	isOwner := false
+	if memoUID, err := ExtractMemoUIDFromName(request.Name); err == nil {
+		if memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID}); err == nil && memo != nil {
+			user, err := s.fetchCurrentUser(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get current user")
+			}
+			if user != nil && user.ID == memo.CreatorID {
+				isOwner = true
+			}
+			if !isOwner {
+				if ok, err := s.canAccessMemo(ctx, user, memo, MemoActionRead); err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to authorize: %v", err)
+				} else if !ok {
+					return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+				}
+			}
+		}
+	}
+
 	response := &v1pb.ListMemoReactionsResponse{
 		Reactions: []*v1pb.Reaction{},
 	}
 	for _, reaction := range reactions {
+		if reaction.ApprovalStatus == store.ReactionApprovalStatusPending && !isOwner {
+			continue
+		}
+		// A flagged reaction is only surfaced to the memo owner until a
+		// moderator clears it; see internal/filter/spam.
+		if reaction.NeedsReview && !isOwner {
+			continue
+		}
 		reactionMessage := convertReactionFromStore(reaction)
 		response.Reactions = append(response.Reactions, reactionMessage)
 	}
@@ -41,48 +73,143 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	reaction, err := s.Store.UpsertReaction(ctx, &store.Reaction{
-		CreatorID:    user.ID,
-		ContentID:    request.Reaction.ContentId,
-		ReactionType: request.Reaction.ReactionType,
-	})
+
+	memoUID, err := ExtractMemoUIDFromName(request.Reaction.ContentId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid content id: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if ok, err := s.canAccessMemo(ctx, user, memo, MemoActionReact); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to authorize: %v", err)
+	} else if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	decision, err := s.evaluateReactionPolicy(ctx, memo, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to evaluate interaction policy: %v", err)
+	}
+	if decision == reactionDecisionDenied {
+		return nil, status.Errorf(codes.PermissionDenied, "this memo's interaction policy does not allow you to react")
+	}
+
+	approvalStatus := store.ReactionApprovalStatusAccepted
+	if decision == reactionDecisionPending {
+		approvalStatus = store.ReactionApprovalStatusPending
+	}
+
+	candidate := &store.Reaction{
+		CreatorID:      user.ID,
+		ContentID:      request.Reaction.ContentId,
+		ReactionType:   request.Reaction.ReactionType,
+		ApprovalStatus: approvalStatus,
+	}
+	drop, flag, filterName, err := defaultSpamPipeline.CheckReaction(ctx, user.ID, candidate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to run spam filters: %v", err)
+	}
+	if drop {
+		return nil, resourceExhaustedWithRetry(
+			fmt.Sprintf("reaction rejected by the %s filter, please retry later", filterName),
+			reactionSpamRetryAfter,
+		)
+	}
+	candidate.NeedsReview = flag
+
+	reaction, err := s.Store.UpsertReaction(ctx, candidate)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert reaction")
 	}
+	if flag {
+		if _, err := s.Store.CreateFlaggedItem(ctx, &store.FlaggedItem{
+			ItemType:   store.FlaggedItemTypeReaction,
+			ItemID:     reaction.ID,
+			FilterName: filterName,
+		}); err != nil {
+			slog.Warn("Failed to record flagged reaction", slog.Any("err", err))
+		}
+	}
 
 	reactionMessage := convertReactionFromStore(reaction)
 
 	// Try to dispatch webhook when reaction is created.
 	// Use store layer to bypass permission checks - webhooks should always notify memo creator.
-	memoUID, err := ExtractMemoUIDFromName(request.Reaction.ContentId)
-	if err == nil {
-		// Fetch memo directly from store (bypasses permission checks)
-		if memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID}); err == nil && memo != nil {
-			// Fetch reactions and attachments for complete webhook payload
-			reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
-				ContentID: &request.Reaction.ContentId,
-			})
-			if err != nil {
-				slog.Warn("Failed to list reactions for webhook", slog.Any("err", err))
-				reactions = []*store.Reaction{}
-			}
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
+		ContentID: &request.Reaction.ContentId,
+	})
+	if err != nil {
+		slog.Warn("Failed to list reactions for webhook", slog.Any("err", err))
+		reactions = []*store.Reaction{}
+	}
 
-			attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
-				MemoID: &memo.ID,
-			})
-			if err != nil {
-				slog.Warn("Failed to list attachments for webhook", slog.Any("err", err))
-				attachments = []*store.Attachment{}
-			}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		slog.Warn("Failed to list attachments for webhook", slog.Any("err", err))
+		attachments = []*store.Attachment{}
+	}
+
+	// Convert memo to protobuf format
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err != nil {
+		slog.Warn("Failed to convert memo for reaction webhook", slog.Any("err", err))
+		return reactionMessage, nil
+	}
 
-			// Convert memo to protobuf format
-			if memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments); err == nil {
-				// Dispatch webhook
-				if err := s.DispatchMemoReactedWebhook(ctx, memoMessage, reactionMessage); err != nil {
-					slog.Warn("Failed to dispatch memo reacted webhook", slog.Any("err", err))
+	if approvalStatus == store.ReactionApprovalStatusPending {
+		if err := s.DispatchMemoReactionRequestedWebhook(ctx, memoMessage, reactionMessage); err != nil {
+			slog.Warn("Failed to dispatch memo reaction requested webhook", slog.Any("err", err))
+		}
+		return reactionMessage, nil
+	}
+
+	if err := s.DispatchMemoReactedWebhook(ctx, memoMessage, reactionMessage); err != nil {
+		slog.Warn("Failed to dispatch memo reacted webhook", slog.Any("err", err))
+	}
+
+	return reactionMessage, nil
+}
+
+// ApproveMemoReaction accepts a reaction that is pending the memo owner's
+// approval, making it visible to other viewers and firing the accepted
+// webhook.
+func (s *APIV1Service) ApproveMemoReaction(ctx context.Context, request *v1pb.ApproveMemoReactionRequest) (*v1pb.Reaction, error) {
+	reaction, err := s.resolvePendingReactionForOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction, err = s.Store.UpsertReaction(ctx, &store.Reaction{
+		ID:             reaction.ID,
+		CreatorID:      reaction.CreatorID,
+		ContentID:      reaction.ContentID,
+		ReactionType:   reaction.ReactionType,
+		ApprovalStatus: store.ReactionApprovalStatusAccepted,
+		// Preserve NeedsReview: approving a pending reaction only clears
+		// the owner-approval gate. A reaction a spam filter also flagged
+		// must stay hidden from other viewers until a moderator resolves
+		// it (see ResolveFlaggedContent), not become visible the moment
+		// it's approved.
+		NeedsReview: reaction.NeedsReview,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to approve reaction")
+	}
+	reactionMessage := convertReactionFromStore(reaction)
+
+	if memoUID, err := ExtractMemoUIDFromName(reaction.ContentID); err == nil {
+		if memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID}); err == nil && memo != nil {
+			if memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil); err == nil {
+				if err := s.DispatchMemoReactionAcceptedWebhook(ctx, memoMessage, reactionMessage); err != nil {
+					slog.Warn("Failed to dispatch memo reaction accepted webhook", slog.Any("err", err))
 				}
-			} else {
-				slog.Warn("Failed to convert memo for reaction webhook", slog.Any("err", err))
 			}
 		}
 	}
@@ -90,6 +217,61 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	return reactionMessage, nil
 }
 
+// RejectMemoReaction discards a reaction that is pending the memo owner's
+// approval.
+func (s *APIV1Service) RejectMemoReaction(ctx context.Context, request *v1pb.RejectMemoReactionRequest) (*emptypb.Empty, error) {
+	reaction, err := s.resolvePendingReactionForOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteReaction(ctx, &store.DeleteReaction{ID: reaction.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reject reaction")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// resolvePendingReactionForOwner fetches the reaction named by name and
+// verifies the current user is the owner of its memo and that it is still
+// pending approval.
+func (s *APIV1Service) resolvePendingReactionForOwner(ctx context.Context, name string) (*store.Reaction, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	_, reactionID, err := ExtractMemoReactionIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reaction name: %v", err)
+	}
+	reaction, err := s.Store.GetReaction(ctx, &store.FindReaction{ID: &reactionID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reaction")
+	}
+	if reaction == nil {
+		return nil, status.Errorf(codes.NotFound, "reaction not found")
+	}
+	if reaction.ApprovalStatus != store.ReactionApprovalStatusPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "reaction is not pending approval")
+	}
+
+	memoUID, err := ExtractMemoUIDFromName(reaction.ContentID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve memo for reaction: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil || (memo.CreatorID != user.ID && !isSuperUser(user)) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	return reaction, nil
+}
+
 func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.DeleteMemoReactionRequest) (*emptypb.Empty, error) {
 	user, err := s.fetchCurrentUser(ctx)
 	if err != nil {
@@ -116,7 +298,9 @@ func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.Del
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
-	if reaction.CreatorID != user.ID && !isSuperUser(user) {
+	if ok, err := NewAuthorizer(s.Store).CanManageReaction(ctx, user, reaction); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to authorize: %v", err)
+	} else if !ok {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
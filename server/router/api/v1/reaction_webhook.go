@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// memoReactionRequestedWebhookActivityType and
+// memoReactionAcceptedWebhookActivityType follow the same
+// "memo.<noun>.<verb>" naming as the existing "memo.reacted" activity
+// dispatched by DispatchMemoReactedWebhook.
+const (
+	memoReactionRequestedWebhookActivityType = "memo.reaction.requested"
+	memoReactionAcceptedWebhookActivityType  = "memo.reaction.accepted"
+)
+
+// DispatchMemoReactionRequestedWebhook notifies the memo owner that a
+// reaction is waiting on their approval.
+func (s *APIV1Service) DispatchMemoReactionRequestedWebhook(ctx context.Context, memo *v1pb.Memo, reaction *v1pb.Reaction) error {
+	return s.dispatchMemoReactionActivityWebhook(ctx, memoReactionRequestedWebhookActivityType, memo, reaction)
+}
+
+// DispatchMemoReactionAcceptedWebhook notifies subscribers that a
+// previously pending reaction has been approved and is now visible.
+func (s *APIV1Service) DispatchMemoReactionAcceptedWebhook(ctx context.Context, memo *v1pb.Memo, reaction *v1pb.Reaction) error {
+	return s.dispatchMemoReactionActivityWebhook(ctx, memoReactionAcceptedWebhookActivityType, memo, reaction)
+}
+
+// dispatchMemoReactionActivityWebhook shares the memo/reaction payload shape
+// used by DispatchMemoReactedWebhook, varying only the activity type.
+func (s *APIV1Service) dispatchMemoReactionActivityWebhook(ctx context.Context, activityType string, memo *v1pb.Memo, reaction *v1pb.Reaction) error {
+	return s.DispatchMemoRelatedWebhook(ctx, activityType, memo, reaction)
+}
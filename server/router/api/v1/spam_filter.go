@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/usememos/memos/internal/filter/spam"
+)
+
+// reactionSpamRetryAfter is the retry hint returned to a client whose
+// reaction was dropped by the spam pipeline. It matches the one-token-per-
+// second refill rate configured for the "reaction" action below, so a
+// client that waits this long is likely to have a token again.
+const reactionSpamRetryAfter = time.Second
+
+// memoSpamRetryAfter is the equivalent retry hint for a memo create/update
+// dropped by the spam pipeline.
+const memoSpamRetryAfter = time.Second
+
+func init() {
+	spam.Register("rate-limit", func(map[string]any) (spam.Filter, error) {
+		return spam.NewRateLimitFilter(
+			spam.RateLimitConfig{Action: "reaction", Capacity: 30, RefillRate: reactionSpamRetryAfter},
+			spam.RateLimitConfig{Action: "memo", Capacity: 10, RefillRate: memoSpamRetryAfter},
+		), nil
+	})
+	spam.Register("content", func(map[string]any) (spam.Filter, error) {
+		return spam.NewContentFilter(spam.ContentFilterConfig{
+			ReviewKeywords:        []string{"refund", "giveaway"},
+			MaxReactionsPerMinute: 120,
+		})
+	})
+}
+
+// defaultSpamPipeline is the pipeline UpsertMemoReaction and the memo
+// create/update path run incoming content through. It's a package-level
+// default rather than something threaded through APIV1Service's constructor
+// so that the filters it's built from (and any operator-registered ones
+// pulled in via spam.Build) stay configurable without a breaking change to
+// that constructor; see internal/filter/spam for the pluggable registry.
+var defaultSpamPipeline = mustBuildDefaultSpamPipeline()
+
+func mustBuildDefaultSpamPipeline() *spam.Pipeline {
+	rateLimit, err := spam.Build("rate-limit", nil)
+	if err != nil {
+		panic(err)
+	}
+	content, err := spam.Build("content", nil)
+	if err != nil {
+		panic(err)
+	}
+	return spam.NewPipeline(rateLimit, content)
+}
+
+// resourceExhaustedWithRetry builds the ResourceExhausted status the spam
+// pipeline returns when it drops a reaction or memo, attaching a RetryInfo
+// detail so well-behaved clients back off instead of retrying immediately.
+func resourceExhaustedWithRetry(message string, retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, message)
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/internal/tag"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// memoUpdatedWebhookActivityType follows the same "memo.<verb>" naming as
+// the existing "memo.reacted" activity; a tag rename/move is reported as
+// a plain update rather than anything reaction-specific.
+const memoUpdatedWebhookActivityType = "memo.updated"
+
+// RenameUserTag renames a single tag (and any child tag nested under it)
+// across every memo owned by parent's user.
+func (s *APIV1Service) RenameUserTag(ctx context.Context, request *v1pb.RenameUserTagRequest) (*v1pb.RenameUserTagResponse, error) {
+	return s.cascadeTagChange(ctx, request.Parent, request.Tag, request.NewTag, request.Merge, request.DryRun)
+}
+
+// MoveTagSubtree relocates every tag under oldPrefix (oldPrefix itself
+// included) to newPrefix. It shares RenameUserTag's cascading rewrite
+// engine; the two RPCs differ only in the vocabulary callers use to
+// describe their intent (renaming one tag vs. relocating a whole
+// subtree), not in behavior.
+func (s *APIV1Service) MoveTagSubtree(ctx context.Context, request *v1pb.MoveTagSubtreeRequest) (*v1pb.MoveTagSubtreeResponse, error) {
+	resp, err := s.cascadeTagChange(ctx, request.Parent, request.OldPrefix, request.NewPrefix, request.Merge, request.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.MoveTagSubtreeResponse{MemoCount: resp.MemoCount, MemoNames: resp.MemoNames}, nil
+}
+
+// cascadeTagChange finds every memo belonging to parent's user whose tags
+// contain oldTag or a child of it, rewrites both the tag list and the
+// matching "#oldTag" markdown references, and persists every touched memo
+// in a single transaction, emitting a MemoUpdatedEvent-equivalent webhook
+// per memo once it commits. With dryRun set, it reports the memos that
+// would change without writing anything.
+func (s *APIV1Service) cascadeTagChange(ctx context.Context, parent, oldTag, newTag string, merge, dryRun bool) (*v1pb.RenameUserTagResponse, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	targetUserID, err := ExtractUserIDFromName(parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+	}
+	if user.ID != targetUserID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if oldTag == "" || newTag == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "tag and new_tag are required")
+	}
+	if oldTag == newTag {
+		return &v1pb.RenameUserTagResponse{MemoNames: []string{}}, nil
+	}
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &targetUserID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	if !merge {
+		for _, memo := range memos {
+			for _, t := range memo.Payload.GetTags() {
+				if tag.MatchesOrIsChild(t, newTag) {
+					return nil, status.Errorf(codes.AlreadyExists, "tag %q already exists; pass merge=true to combine them", newTag)
+				}
+			}
+		}
+	}
+
+	type change struct {
+		memo    *store.Memo
+		content string
+		payload *storepb.MemoPayload
+	}
+	var changes []change
+	for _, memo := range memos {
+		renamedTags, tagsChanged := tag.RenameList(memo.Payload.GetTags(), oldTag, newTag)
+		newContent, contentChanged := tag.RenameInContent(memo.Content, oldTag, newTag)
+		if !tagsChanged && !contentChanged {
+			continue
+		}
+
+		payload := memo.Payload
+		if tagsChanged {
+			payload, _ = proto.Clone(memo.Payload).(*storepb.MemoPayload)
+			payload.Tags = renamedTags
+		}
+		changes = append(changes, change{memo: memo, content: newContent, payload: payload})
+	}
+
+	response := &v1pb.RenameUserTagResponse{MemoNames: []string{}}
+	for _, c := range changes {
+		response.MemoNames = append(response.MemoNames, fmt.Sprintf("%s%s", MemoNamePrefix, c.memo.UID))
+	}
+	response.MemoCount = int32(len(response.MemoNames))
+	if dryRun || len(changes) == 0 {
+		return response, nil
+	}
+
+	err = s.Store.WithTransaction(ctx, func(ctx context.Context) error {
+		for _, c := range changes {
+			if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+				ID:      c.memo.ID,
+				Content: &c.content,
+				Payload: c.payload,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rename tag: %v", err)
+	}
+
+	for _, c := range changes {
+		c.memo.Content = c.content
+		c.memo.Payload = c.payload
+		if memoMessage, err := s.convertMemoFromStore(ctx, c.memo, nil, nil); err == nil {
+			if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+				slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// DispatchMemoUpdatedWebhook notifies subscribers that a memo's content or
+// metadata changed outside of the usual UpdateMemo RPC, e.g. a cascading
+// tag rename.
+func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
+	return s.DispatchMemoRelatedWebhook(ctx, memoUpdatedWebhookActivityType, memo, nil)
+}
@@ -0,0 +1,77 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestListMemoReactions_PrivateMemo_DeniedWithoutACL(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateHostUser(ctx, "owner")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  owner.ID,
+		Content:    "private memo",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	_, err = ts.Service.ListMemoReactions(otherCtx, &v1pb.ListMemoReactionsRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestListMemoReactions_PrivateMemo_VisibleToGroupViaACL(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateHostUser(ctx, "owner")
+	require.NoError(t, err)
+	member, err := ts.CreateRegularUser(ctx, "member")
+	require.NoError(t, err)
+
+	group, err := ts.Store.CreateGroup(ctx, &store.Group{Name: "team-a", CreatorID: owner.ID})
+	require.NoError(t, err)
+	require.NoError(t, ts.Store.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: member.ID}))
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  owner.ID,
+		Content:    "shared with team-a",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.Store.UpsertMemoACLEntry(ctx, &store.MemoACLEntry{
+		MemoID:  memo.ID,
+		Subject: "group:team-a",
+		Role:    store.MemoRoleReader,
+	}))
+
+	memberCtx := ts.CreateUserContext(ctx, member.ID)
+	response, err := ts.Service.ListMemoReactions(memberCtx, &v1pb.ListMemoReactionsRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, response)
+}
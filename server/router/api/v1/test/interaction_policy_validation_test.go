@@ -0,0 +1,38 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	v1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestValidateInteractionPolicy_AcceptsSupportedPrincipals(t *testing.T) {
+	err := v1.ValidateInteractionPolicy(&storepb.InteractionPolicy{
+		CanReact: &storepb.InteractionRule{
+			Always:       []string{"public", "authenticated"},
+			WithApproval: []string{"users/1"},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestValidateInteractionPolicy_AcceptsNil(t *testing.T) {
+	require.NoError(t, v1.ValidateInteractionPolicy(nil))
+}
+
+func TestValidateInteractionPolicy_RejectsFollowers(t *testing.T) {
+	err := v1.ValidateInteractionPolicy(&storepb.InteractionPolicy{
+		CanComment: &storepb.InteractionRule{Always: []string{"followers/users/1"}},
+	})
+	require.Error(t, err)
+}
+
+func TestValidateInteractionPolicy_RejectsTag(t *testing.T) {
+	err := v1.ValidateInteractionPolicy(&storepb.InteractionPolicy{
+		CanReact: &storepb.InteractionRule{WithApproval: []string{"tag:family"}},
+	})
+	require.Error(t, err)
+}
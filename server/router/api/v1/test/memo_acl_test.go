@@ -0,0 +1,161 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUpdateMemoAcl_OwnerGrantsAndRevokesAccess(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateHostUser(ctx, "owner")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  owner.ID,
+		Content:    "private memo",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	_, err = ts.Service.ListMemoReactions(otherCtx, &v1pb.ListMemoReactionsRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.UpdateMemoAcl(ownerCtx, &v1pb.UpdateMemoAclRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+		Entries: []*v1pb.MemoAclEntry{
+			{Subject: fmt.Sprintf("users/%d", other.ID), Role: v1pb.MemoRole_READER},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.ListMemoReactions(otherCtx, &v1pb.ListMemoReactionsRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+	})
+	require.NoError(t, err)
+
+	// Replacing with an empty set of entries revokes the grant.
+	_, err = ts.Service.UpdateMemoAcl(ownerCtx, &v1pb.UpdateMemoAclRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.ListMemoReactions(otherCtx, &v1pb.ListMemoReactionsRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUpdateMemoAcl_RejectsUnspecifiedRole(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateHostUser(ctx, "owner")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  owner.ID,
+		Content:    "private memo",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	_, err = ts.Service.UpdateMemoAcl(ownerCtx, &v1pb.UpdateMemoAclRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+		Entries: []*v1pb.MemoAclEntry{
+			// A client that forgets to set Role sends the proto zero
+			// value; that must be rejected rather than silently
+			// granting read access.
+			{Subject: fmt.Sprintf("users/%d", other.ID)},
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateMemoAcl_RequiresOwnerOrAdmin(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateHostUser(ctx, "owner")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  owner.ID,
+		Content:    "private memo",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	_, err = ts.Service.UpdateMemoAcl(otherCtx, &v1pb.UpdateMemoAclRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestDeleteMemoReaction_MemoOwnerCanModerate(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateHostUser(ctx, "owner")
+	require.NoError(t, err)
+	reactor, err := ts.CreateRegularUser(ctx, "reactor")
+	require.NoError(t, err)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  owner.ID,
+		Content:    "public memo",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	reaction, err := ts.Store.UpsertReaction(ctx, &store.Reaction{
+		CreatorID:    reactor.ID,
+		ContentID:    fmt.Sprintf("memos/%s", memo.UID),
+		ReactionType: "👍",
+	})
+	require.NoError(t, err)
+
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	_, err = ts.Service.DeleteMemoReaction(ownerCtx, &v1pb.DeleteMemoReactionRequest{
+		Name: fmt.Sprintf("%s/%s%d", reaction.ContentID, ReactionNamePrefix, reaction.ID),
+	})
+	require.NoError(t, err, "the memo's owner should be able to moderate reactions left on it")
+}
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestCreateMemo_SpamRateLimit_Dropped(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "memo_creator")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// The default rate-limit filter allows a burst of 10 memos per user;
+	// the 11th within the same instant should be dropped.
+	for i := 0; i < 10; i++ {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: fmt.Sprintf("memo %d", i)},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "memo 10"},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestCreateMemo_FlaggedContentRoutedToModeration(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "memo_flag_admin")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "memo_flag_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+
+	// "refund" is a configured review keyword: it's flagged for a
+	// moderator to look at, not dropped outright.
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "please process my refund"},
+	})
+	require.NoError(t, err)
+
+	listed, err := ts.Service.ListFlaggedContent(adminCtx, &v1pb.ListFlaggedContentRequest{})
+	require.NoError(t, err)
+	require.Len(t, listed.FlaggedContent, 1)
+	require.Equal(t, memo.Name, listed.FlaggedContent[0].Item)
+}
+
+func TestUpdateMemo_RequiresOwnerOrAdmin(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateRegularUser(ctx, "memo_update_owner")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "memo_update_other")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	memo, err := ts.Service.CreateMemo(ownerCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "original content"},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.UpdateMemo(otherCtx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{Name: memo.Name, Content: "hijacked content"},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	updated, err := ts.Service.UpdateMemo(ownerCtx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{Name: memo.Name, Content: "updated content"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "updated content", updated.Content)
+}
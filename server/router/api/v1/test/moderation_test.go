@@ -0,0 +1,149 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUpsertMemoReaction_SpamRateLimit_Dropped(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateHostUser(ctx, "owner")
+	require.NoError(t, err)
+	reactor, err := ts.CreateRegularUser(ctx, "reactor")
+	require.NoError(t, err)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  owner.ID,
+		Content:    "rate limit me",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	reactorCtx := ts.CreateUserContext(ctx, reactor.ID)
+	// The default rate-limit filter allows a burst of 30 reactions per
+	// user; the 31st within the same instant should be dropped.
+	for i := 0; i < 30; i++ {
+		_, err := ts.Service.UpsertMemoReaction(reactorCtx, &v1pb.UpsertMemoReactionRequest{
+			Reaction: &v1pb.Reaction{
+				ContentId:    fmt.Sprintf("memos/%s", memo.UID),
+				ReactionType: fmt.Sprintf("emoji-%d", i),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = ts.Service.UpsertMemoReaction(reactorCtx, &v1pb.UpsertMemoReactionRequest{
+		Reaction: &v1pb.Reaction{
+			ContentId:    fmt.Sprintf("memos/%s", memo.UID),
+			ReactionType: "emoji-30",
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestListFlaggedContent_RequiresAdmin(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	_, err = ts.Service.ListFlaggedContent(otherCtx, &v1pb.ListFlaggedContentRequest{})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestModerationFlow_ResolveAndPurge(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateHostUser(ctx, "owner")
+	require.NoError(t, err)
+	reactor, err := ts.CreateRegularUser(ctx, "reactor")
+	require.NoError(t, err)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  owner.ID,
+		Content:    "needs a look",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	reaction, err := ts.Store.UpsertReaction(ctx, &store.Reaction{
+		CreatorID:    reactor.ID,
+		ContentID:    fmt.Sprintf("memos/%s", memo.UID),
+		ReactionType: "👍",
+		NeedsReview:  true,
+	})
+	require.NoError(t, err)
+	_, err = ts.Store.CreateFlaggedItem(ctx, &store.FlaggedItem{
+		ItemType:   store.FlaggedItemTypeReaction,
+		ItemID:     reaction.ID,
+		FilterName: "content",
+	})
+	require.NoError(t, err)
+
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+
+	listed, err := ts.Service.ListFlaggedContent(ownerCtx, &v1pb.ListFlaggedContentRequest{})
+	require.NoError(t, err)
+	require.Len(t, listed.FlaggedContent, 1)
+	flaggedName := listed.FlaggedContent[0].Name
+
+	_, err = ts.Service.ResolveFlaggedContent(ownerCtx, &v1pb.ResolveFlaggedContentRequest{
+		Names: []string{flaggedName},
+	})
+	require.NoError(t, err)
+
+	listed, err = ts.Service.ListFlaggedContent(ownerCtx, &v1pb.ListFlaggedContentRequest{})
+	require.NoError(t, err)
+	require.Empty(t, listed.FlaggedContent, "resolved items drop out of the default NEEDS_REVIEW view")
+
+	stillThere, err := ts.Store.GetReaction(ctx, &store.FindReaction{ID: &reaction.ID})
+	require.NoError(t, err)
+	require.NotNil(t, stillThere, "resolving must not delete the underlying reaction")
+	require.False(t, stillThere.NeedsReview, "resolving must clear NeedsReview so the reaction becomes visible to non-owners again")
+
+	secondReaction, err := ts.Store.UpsertReaction(ctx, &store.Reaction{
+		CreatorID:    reactor.ID,
+		ContentID:    fmt.Sprintf("memos/%s", memo.UID),
+		ReactionType: "👎",
+		NeedsReview:  true,
+	})
+	require.NoError(t, err)
+	secondFlagged, err := ts.Store.CreateFlaggedItem(ctx, &store.FlaggedItem{
+		ItemType:   store.FlaggedItemTypeReaction,
+		ItemID:     secondReaction.ID,
+		FilterName: "content",
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.PurgeFlaggedContent(ownerCtx, &v1pb.PurgeFlaggedContentRequest{
+		Names: []string{fmt.Sprintf("%s%d", FlaggedContentNamePrefix, secondFlagged.ID)},
+	})
+	require.NoError(t, err)
+
+	purgedReaction, err := ts.Store.GetReaction(ctx, &store.FindReaction{ID: &secondReaction.ID})
+	require.NoError(t, err)
+	require.Nil(t, purgedReaction, "purging must delete the underlying reaction")
+}
@@ -0,0 +1,754 @@
+// Package test's permissions_test.go is a declarative "security spec" for
+// every RPC APIV1Service exposes, in the spirit of Vanadium syncbase's
+// table-driven permission tests: one table maps (RPC, principal) pairs to
+// the gRPC status the call must produce, instead of each handler's
+// authorization behavior being exercised ad hoc by whichever feature test
+// happened to touch it. TestPermissionSpec_CoversEveryRPC uses reflection
+// to catch spec rows left behind after their RPC is renamed or removed; see
+// its doc comment for why it can't assert the reverse (every RPC has a row)
+// from this package alone.
+package test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// principal names one of the standing relationships a caller can have to
+// the fixture this spec drives every RPC against.
+type principal string
+
+const (
+	// principalOwner created the fixture's memos, group, and reactions.
+	principalOwner principal = "owner"
+	// principalAdmin is a host/superuser with no direct relationship to
+	// the fixture's content; admin-only RPCs are expected to let it
+	// through anyway.
+	principalAdmin principal = "admin"
+	// principalAuthenticated is any other logged-in user with no special
+	// standing — the baseline "is merely logged in" check.
+	principalAuthenticated principal = "authenticated"
+	// principalPublic is an unauthenticated caller.
+	principalPublic principal = "public"
+	// principalGroupMember belongs to the group the fixture's private
+	// memo shares reader/reactor access with via ACL.
+	principalGroupMember principal = "group-member"
+	// principalOtherUser is a second unrelated, unauthorized user, and a
+	// genuinely distinct identity from principalAuthenticated: some RPCs
+	// grant standing to one specific other user (e.g. the creator of the
+	// reaction being deleted) without granting it to every logged-in
+	// stranger, and the two principals must map to different accounts for
+	// a spec row to be able to tell those cases apart.
+	principalOtherUser principal = "other-user"
+)
+
+var allPrincipals = []principal{
+	principalOwner,
+	principalAdmin,
+	principalAuthenticated,
+	principalPublic,
+	principalGroupMember,
+	principalOtherUser,
+}
+
+// permissionFixture is the single seeded world every spec row's call runs
+// against. Read-only RPCs act on its shared memos/reactions directly;
+// mutating RPCs create their own disposable rows (via freshMemo/nextSeq)
+// so that running a spec row for one principal never changes the outcome
+// of running it for another.
+type permissionFixture struct {
+	t  *testing.T
+	ts *TestService
+
+	admin             *store.User
+	owner             *store.User
+	otherUser         *store.User
+	authenticatedUser *store.User
+	groupMember       *store.User
+
+	group *store.Group
+
+	// publicMemo is owned by owner and world-readable.
+	publicMemo *store.Memo
+	// privateMemo is owned by owner, Private, and shared with group via a
+	// reader+reactor ACL entry so principalGroupMember can read/react but
+	// not edit it.
+	privateMemo *store.Memo
+
+	// reaction is an accepted reaction otherUser left on publicMemo.
+	reaction *store.Reaction
+
+	seq int
+}
+
+func (f *permissionFixture) ctxFor(p principal) context.Context {
+	switch p {
+	case principalOwner:
+		return f.ts.CreateUserContext(context.Background(), f.owner.ID)
+	case principalAdmin:
+		return f.ts.CreateUserContext(context.Background(), f.admin.ID)
+	case principalAuthenticated:
+		return f.ts.CreateUserContext(context.Background(), f.authenticatedUser.ID)
+	case principalOtherUser:
+		return f.ts.CreateUserContext(context.Background(), f.otherUser.ID)
+	case principalGroupMember:
+		return f.ts.CreateUserContext(context.Background(), f.groupMember.ID)
+	case principalPublic:
+		return context.Background()
+	default:
+		panic(fmt.Sprintf("permissions_test: unknown principal %q", p))
+	}
+}
+
+// nextSeq hands out a monotonically increasing int a spec row's call can
+// fold into a UID or tag name to keep its own disposable fixtures from
+// colliding with another row's.
+func (f *permissionFixture) nextSeq() int {
+	f.seq++
+	return f.seq
+}
+
+// freshMemo creates a single-use memo so a mutating spec row (UpdateMemo,
+// DeleteMemo, RenameUserTag, ...) never touches the shared fixture memos.
+func (f *permissionFixture) freshMemo(creatorID int32, visibility store.Visibility, tags ...string) *store.Memo {
+	memo, err := f.ts.Store.CreateMemo(context.Background(), &store.Memo{
+		UID:        fmt.Sprintf("perm-fresh-%d", f.nextSeq()),
+		CreatorID:  creatorID,
+		Content:    "fresh fixture memo",
+		Visibility: visibility,
+		Payload:    &storepb.MemoPayload{Tags: tags},
+	})
+	if err != nil {
+		f.t.Fatalf("permissions_test: failed to create fresh memo: %v", err)
+	}
+	return memo
+}
+
+func setupPermissionFixture(t *testing.T) *permissionFixture {
+	ctx := context.Background()
+	ts := NewTestService(t)
+
+	admin, err := ts.CreateHostUser(ctx, "perm_admin")
+	require.NoError(t, err)
+	owner, err := ts.CreateRegularUser(ctx, "perm_owner")
+	require.NoError(t, err)
+	otherUser, err := ts.CreateRegularUser(ctx, "perm_other")
+	require.NoError(t, err)
+	authenticatedUser, err := ts.CreateRegularUser(ctx, "perm_authenticated")
+	require.NoError(t, err)
+	groupMember, err := ts.CreateRegularUser(ctx, "perm_group_member")
+	require.NoError(t, err)
+
+	group, err := ts.Store.CreateGroup(ctx, &store.Group{Name: "perm-spec-group", CreatorID: admin.ID})
+	require.NoError(t, err)
+	require.NoError(t, ts.Store.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: groupMember.ID}))
+
+	publicMemo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "perm-public-memo",
+		CreatorID:  owner.ID,
+		Content:    "public #work memo",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"work"}},
+	})
+	require.NoError(t, err)
+
+	privateMemo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "perm-private-memo",
+		CreatorID:  owner.ID,
+		Content:    "private #work/secret memo",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{Tags: []string{"work/secret"}},
+	})
+	require.NoError(t, err)
+	groupSubject := fmt.Sprintf("group:%s", group.Name)
+	require.NoError(t, ts.Store.UpsertMemoACLEntry(ctx, &store.MemoACLEntry{MemoID: privateMemo.ID, Subject: groupSubject, Role: store.MemoRoleReader}))
+	require.NoError(t, ts.Store.UpsertMemoACLEntry(ctx, &store.MemoACLEntry{MemoID: privateMemo.ID, Subject: groupSubject, Role: store.MemoRoleReactor}))
+
+	reaction, err := ts.Store.UpsertReaction(ctx, &store.Reaction{
+		CreatorID:    otherUser.ID,
+		ContentID:    fmt.Sprintf("memos/%s", publicMemo.UID),
+		ReactionType: "👍",
+	})
+	require.NoError(t, err)
+
+	return &permissionFixture{
+		t:                 t,
+		ts:                ts,
+		admin:             admin,
+		owner:             owner,
+		otherUser:         otherUser,
+		authenticatedUser: authenticatedUser,
+		groupMember:       groupMember,
+		group:             group,
+		publicMemo:        publicMemo,
+		privateMemo:       privateMemo,
+		reaction:          reaction,
+	}
+}
+
+// expect is the gRPC status a spec row's call must produce for a given
+// principal. codes.OK means the call must succeed.
+type expect map[principal]codes.Code
+
+// rpcSpec is one row of the permission spec: how to invoke an RPC, and
+// what every principal should get back from it.
+type rpcSpec struct {
+	call func(ctx context.Context, fx *permissionFixture) error
+	want expect
+}
+
+// permissionSpecs is keyed by the exact APIV1Service method name so
+// TestPermissionSpec_CoversEveryRPC can match it against reflection.
+var permissionSpecs = map[string]rpcSpec{
+	"ListMemoReactions": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			_, err := fx.ts.Service.ListMemoReactions(ctx, &v1pb.ListMemoReactionsRequest{
+				Name: fmt.Sprintf("memos/%s", fx.privateMemo.UID),
+			})
+			return err
+		},
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.OK,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.PermissionDenied,
+		},
+	},
+	"UpsertMemoReaction": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			_, err := fx.ts.Service.UpsertMemoReaction(ctx, &v1pb.UpsertMemoReactionRequest{
+				Reaction: &v1pb.Reaction{
+					ContentId:    fmt.Sprintf("memos/%s", fx.privateMemo.UID),
+					ReactionType: fmt.Sprintf("emoji-%d", fx.nextSeq()),
+				},
+			})
+			return err
+		},
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.OK,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.Unauthenticated,
+		},
+	},
+	"DeleteMemoReaction": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			reaction, err := fx.ts.Store.UpsertReaction(context.Background(), &store.Reaction{
+				CreatorID:    fx.otherUser.ID,
+				ContentID:    fmt.Sprintf("memos/%s", fx.publicMemo.UID),
+				ReactionType: fmt.Sprintf("delete-me-%d", fx.nextSeq()),
+			})
+			if err != nil {
+				return err
+			}
+			_, err = fx.ts.Service.DeleteMemoReaction(ctx, &v1pb.DeleteMemoReactionRequest{
+				Name: fmt.Sprintf("%s/%s%d", reaction.ContentID, ReactionNamePrefix, reaction.ID),
+			})
+			return err
+		},
+		want: expect{
+			// otherUser created the reaction in this call, so only
+			// "other-user" is its creator and may delete their own
+			// reaction; "authenticated" is a distinct, unrelated user
+			// and is denied. The memo's owner may also remove it, per
+			// Authorizer.CanManageReaction.
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.OK,
+			principalAdmin:         codes.OK,
+			principalOwner:         codes.OK,
+			principalGroupMember:   codes.PermissionDenied,
+			principalPublic:        codes.Unauthenticated,
+		},
+	},
+	"ApproveMemoReaction": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			pending, err := fx.ts.Store.UpsertReaction(context.Background(), &store.Reaction{
+				CreatorID:      fx.groupMember.ID,
+				ContentID:      fmt.Sprintf("memos/%s", fx.privateMemo.UID),
+				ReactionType:   fmt.Sprintf("pending-%d", fx.nextSeq()),
+				ApprovalStatus: store.ReactionApprovalStatusPending,
+			})
+			if err != nil {
+				return err
+			}
+			_, err = fx.ts.Service.ApproveMemoReaction(ctx, &v1pb.ApproveMemoReactionRequest{
+				Name: fmt.Sprintf("%s/%s%d", pending.ContentID, ReactionNamePrefix, pending.ID),
+			})
+			return err
+		},
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.Unauthenticated,
+		},
+	},
+	"RejectMemoReaction": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			pending, err := fx.ts.Store.UpsertReaction(context.Background(), &store.Reaction{
+				CreatorID:      fx.groupMember.ID,
+				ContentID:      fmt.Sprintf("memos/%s", fx.privateMemo.UID),
+				ReactionType:   fmt.Sprintf("pending-%d", fx.nextSeq()),
+				ApprovalStatus: store.ReactionApprovalStatusPending,
+			})
+			if err != nil {
+				return err
+			}
+			_, err = fx.ts.Service.RejectMemoReaction(ctx, &v1pb.RejectMemoReactionRequest{
+				Name: fmt.Sprintf("%s/%s%d", pending.ContentID, ReactionNamePrefix, pending.ID),
+			})
+			return err
+		},
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.Unauthenticated,
+		},
+	},
+	"ListUserTags": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			_, err := fx.ts.Service.ListUserTags(ctx, &v1pb.ListUserTagsRequest{
+				Parent: fmt.Sprintf("users/%d", fx.owner.ID),
+			})
+			return err
+		},
+		// ListUserTags never rejects a caller outright; it filters the
+		// returned tag set by what the caller may see (see
+		// TestListUserTags_VisibilityPermissions), so every principal
+		// gets codes.OK.
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.OK,
+			principalAuthenticated: codes.OK,
+			principalOtherUser:     codes.OK,
+			principalPublic:        codes.OK,
+		},
+	},
+	"RenameUserTag": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			fx.freshMemo(fx.owner.ID, store.Public, "work")
+			_, err := fx.ts.Service.RenameUserTag(ctx, &v1pb.RenameUserTagRequest{
+				Parent: fmt.Sprintf("users/%d", fx.owner.ID),
+				Tag:    "work",
+				NewTag: fmt.Sprintf("job-%d", fx.nextSeq()),
+			})
+			return err
+		},
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.Unauthenticated,
+		},
+	},
+	"MoveTagSubtree": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			fx.freshMemo(fx.owner.ID, store.Public, "archive")
+			_, err := fx.ts.Service.MoveTagSubtree(ctx, &v1pb.MoveTagSubtreeRequest{
+				Parent:    fmt.Sprintf("users/%d", fx.owner.ID),
+				OldPrefix: "archive",
+				NewPrefix: fmt.Sprintf("archive-%d", fx.nextSeq()),
+			})
+			return err
+		},
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.Unauthenticated,
+		},
+	},
+	"CreateGroup": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			_, err := fx.ts.Service.CreateGroup(ctx, &v1pb.CreateGroupRequest{
+				Group: &v1pb.Group{DisplayName: fmt.Sprintf("spec-group-%d", fx.nextSeq())},
+			})
+			return err
+		},
+		want: expect{
+			principalAdmin:         codes.OK,
+			principalOwner:         codes.PermissionDenied,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.PermissionDenied,
+		},
+	},
+	"ListGroups": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			_, err := fx.ts.Service.ListGroups(ctx, &v1pb.ListGroupsRequest{})
+			return err
+		},
+		// Listing groups carries no secrets worth gating; every
+		// principal, including an unauthenticated one, may call it.
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.OK,
+			principalAuthenticated: codes.OK,
+			principalOtherUser:     codes.OK,
+			principalPublic:        codes.OK,
+		},
+	},
+	"DeleteGroup": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			group, err := fx.ts.Store.CreateGroup(context.Background(), &store.Group{
+				Name:      fmt.Sprintf("spec-delete-group-%d", fx.nextSeq()),
+				CreatorID: fx.admin.ID,
+			})
+			if err != nil {
+				return err
+			}
+			_, err = fx.ts.Service.DeleteGroup(ctx, &v1pb.DeleteGroupRequest{
+				Name: fmt.Sprintf("%s%d", GroupNamePrefix, group.ID),
+			})
+			return err
+		},
+		want: expect{
+			principalAdmin:         codes.OK,
+			principalOwner:         codes.PermissionDenied,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.PermissionDenied,
+		},
+	},
+	"UpsertGroupMember": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			_, err := fx.ts.Service.UpsertGroupMember(ctx, &v1pb.UpsertGroupMemberRequest{
+				Group:  fmt.Sprintf("%s%d", GroupNamePrefix, fx.group.ID),
+				Member: fmt.Sprintf("users/%d", fx.otherUser.ID),
+			})
+			return err
+		},
+		want: expect{
+			principalAdmin:         codes.OK,
+			principalOwner:         codes.PermissionDenied,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.PermissionDenied,
+		},
+	},
+	"DeleteGroupMember": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			_, err := fx.ts.Service.DeleteGroupMember(ctx, &v1pb.DeleteGroupMemberRequest{
+				Group:  fmt.Sprintf("%s%d", GroupNamePrefix, fx.group.ID),
+				Member: fmt.Sprintf("users/%d", fx.groupMember.ID),
+			})
+			return err
+		},
+		want: expect{
+			principalAdmin:         codes.OK,
+			principalOwner:         codes.PermissionDenied,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.PermissionDenied,
+		},
+	},
+	"ListFlaggedContent": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			_, err := fx.ts.Service.ListFlaggedContent(ctx, &v1pb.ListFlaggedContentRequest{})
+			return err
+		},
+		want: expect{
+			principalAdmin:         codes.OK,
+			principalOwner:         codes.PermissionDenied,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.PermissionDenied,
+		},
+	},
+	"ResolveFlaggedContent": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			flagged := fx.freshFlaggedReaction()
+			_, err := fx.ts.Service.ResolveFlaggedContent(ctx, &v1pb.ResolveFlaggedContentRequest{
+				Names: []string{fmt.Sprintf("%s%d", FlaggedContentNamePrefix, flagged.ID)},
+			})
+			return err
+		},
+		want: expect{
+			principalAdmin:         codes.OK,
+			principalOwner:         codes.PermissionDenied,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.PermissionDenied,
+		},
+	},
+	"PurgeFlaggedContent": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			flagged := fx.freshFlaggedReaction()
+			_, err := fx.ts.Service.PurgeFlaggedContent(ctx, &v1pb.PurgeFlaggedContentRequest{
+				Names: []string{fmt.Sprintf("%s%d", FlaggedContentNamePrefix, flagged.ID)},
+			})
+			return err
+		},
+		want: expect{
+			principalAdmin:         codes.OK,
+			principalOwner:         codes.PermissionDenied,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.PermissionDenied,
+		},
+	},
+	"CreateMemo": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			_, err := fx.ts.Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+				Memo: &v1pb.Memo{Content: "a new memo"},
+			})
+			return err
+		},
+		// Creating a memo only requires being logged in; who owns it is
+		// decided by the caller's own identity, not a relationship to
+		// existing content.
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.OK,
+			principalAuthenticated: codes.OK,
+			principalOtherUser:     codes.OK,
+			principalPublic:        codes.Unauthenticated,
+		},
+	},
+	"GetMemo": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			_, err := fx.ts.Service.GetMemo(ctx, &v1pb.GetMemoRequest{
+				Name: fmt.Sprintf("memos/%s", fx.privateMemo.UID),
+			})
+			return err
+		},
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.OK,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.PermissionDenied,
+		},
+	},
+	"UpdateMemo": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			memo := fx.freshMemo(fx.owner.ID, store.Private)
+			newContent := "edited content"
+			_, err := fx.ts.Service.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+				Memo: &v1pb.Memo{Name: fmt.Sprintf("memos/%s", memo.UID), Content: newContent},
+			})
+			return err
+		},
+		want: expect{
+			principalOwner: codes.OK,
+			principalAdmin: codes.OK,
+			// Editing requires more than the reader/reactor roles the
+			// fixture's ACL grants the group, so even a principal that
+			// can read and react to the private memo can't edit it.
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.Unauthenticated,
+		},
+	},
+	"DeleteMemo": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			memo := fx.freshMemo(fx.owner.ID, store.Private)
+			_, err := fx.ts.Service.DeleteMemo(ctx, &v1pb.DeleteMemoRequest{
+				Name: fmt.Sprintf("memos/%s", memo.UID),
+			})
+			return err
+		},
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.Unauthenticated,
+		},
+	},
+	"UpdateMemoAcl": {
+		call: func(ctx context.Context, fx *permissionFixture) error {
+			memo := fx.freshMemo(fx.owner.ID, store.Private)
+			_, err := fx.ts.Service.UpdateMemoAcl(ctx, &v1pb.UpdateMemoAclRequest{
+				Name: fmt.Sprintf("memos/%s", memo.UID),
+			})
+			return err
+		},
+		// Only the memo's creator or an admin may replace its ACL, per
+		// UpdateMemoAcl's own doc comment -- not even a reader/reactor
+		// the memo is already shared with.
+		want: expect{
+			principalOwner:         codes.OK,
+			principalAdmin:         codes.OK,
+			principalGroupMember:   codes.PermissionDenied,
+			principalAuthenticated: codes.PermissionDenied,
+			principalOtherUser:     codes.PermissionDenied,
+			principalPublic:        codes.Unauthenticated,
+		},
+	},
+}
+
+// freshFlaggedReaction seeds a disposable flagged reaction so a mutating
+// moderation spec row never depends on, or clobbers, another row's state.
+func (f *permissionFixture) freshFlaggedReaction() *store.FlaggedItem {
+	reaction, err := f.ts.Store.UpsertReaction(context.Background(), &store.Reaction{
+		CreatorID:    f.otherUser.ID,
+		ContentID:    fmt.Sprintf("memos/%s", f.publicMemo.UID),
+		ReactionType: fmt.Sprintf("flag-me-%d", f.nextSeq()),
+		NeedsReview:  true,
+	})
+	if err != nil {
+		f.t.Fatalf("permissions_test: failed to create reaction to flag: %v", err)
+	}
+	flagged, err := f.ts.Store.CreateFlaggedItem(context.Background(), &store.FlaggedItem{
+		ItemType:   store.FlaggedItemTypeReaction,
+		ItemID:     reaction.ID,
+		FilterName: "content",
+	})
+	if err != nil {
+		f.t.Fatalf("permissions_test: failed to create flagged item: %v", err)
+	}
+	return flagged
+}
+
+// TestPermissionSpec runs every row in permissionSpecs against every
+// principal it declares an expectation for.
+func TestPermissionSpec(t *testing.T) {
+	for name, spec := range permissionSpecs {
+		name, spec := name, spec
+		t.Run(name, func(t *testing.T) {
+			for _, p := range allPrincipals {
+				wantCode, ok := spec.want[p]
+				if !ok {
+					continue
+				}
+				t.Run(string(p), func(t *testing.T) {
+					fx := setupPermissionFixture(t)
+					defer fx.ts.Cleanup()
+
+					err := spec.call(fx.ctxFor(p), fx)
+					if wantCode == codes.OK {
+						require.NoError(t, err)
+						return
+					}
+					require.Error(t, err)
+					require.Equal(t, wantCode, status.Code(err))
+				})
+			}
+		})
+	}
+}
+
+// everyGeneratedServerInterface lists every *ServiceServer interface
+// proto/gen/api/v1 declares. APIV1Service's full real definition (auth,
+// user profile, workspace, webhook, resource, inbox, activity,
+// identity-provider RPCs, and the rest of MemoService) lives elsewhere and
+// isn't visible to reflection against this package, but these generated
+// interfaces are: they're exactly the RPC surface this series actually
+// added, so TestPermissionSpec_CoversEveryRPC can assert real, two-way
+// coverage against them instead of guessing at a surface it can't see.
+var everyGeneratedServerInterface = []reflect.Type{
+	reflect.TypeOf((*v1pb.ReactionServiceServer)(nil)).Elem(),
+	reflect.TypeOf((*v1pb.GroupServiceServer)(nil)).Elem(),
+	reflect.TypeOf((*v1pb.ModerationServiceServer)(nil)).Elem(),
+	reflect.TypeOf((*v1pb.UserServiceServer)(nil)).Elem(),
+	reflect.TypeOf((*v1pb.MemoServiceServer)(nil)).Elem(),
+}
+
+// TestPermissionSpec_CoversEveryRPC reflects over every *ServiceServer
+// interface generated for this series (see everyGeneratedServerInterface)
+// and fails the build if a method on one of those interfaces has no
+// matching row in permissionSpecs — e.g. UpdateMemoAcl was added to
+// MemoServiceServer by one of this series' commits and needs a row here.
+//
+// It also still checks the direction this package could always assert:
+// every row in permissionSpecs names a method that exists on APIV1Service
+// with an RPC handler's shape, catching a spec left behind after its RPC
+// is renamed or removed. It does NOT require every spec row to appear on
+// one of the generated interfaces above: CreateMemo/GetMemo/UpdateMemo/
+// DeleteMemo predate this series, are unchanged by it, and so were never
+// declared in memo_service.proto (see that file's service comment) — they
+// have spec rows but no corresponding generated interface method to check
+// against here.
+func TestPermissionSpec_CoversEveryRPC(t *testing.T) {
+	fx := setupPermissionFixture(t)
+	defer fx.ts.Cleanup()
+
+	svcType := reflect.TypeOf(fx.ts.Service)
+
+	generatedRPCs := map[string]bool{}
+	for _, ifaceType := range everyGeneratedServerInterface {
+		require.True(t, svcType.Implements(ifaceType), "APIV1Service must implement %s", ifaceType)
+		for i := 0; i < ifaceType.NumMethod(); i++ {
+			generatedRPCs[ifaceType.Method(i).Name] = true
+		}
+	}
+	for name := range generatedRPCs {
+		if _, ok := permissionSpecs[name]; !ok {
+			t.Errorf("%q is a generated RPC but permissionSpecs has no row for it", name)
+		}
+	}
+
+	for name := range permissionSpecs {
+		method, ok := svcType.MethodByName(name)
+		if !ok {
+			t.Errorf("permissionSpecs has a row for %q but APIV1Service has no such method", name)
+			continue
+		}
+		if !looksLikeRPCHandler(method) {
+			t.Errorf("permissionSpecs row %q does not match an RPC handler's signature", name)
+		}
+	}
+}
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// looksLikeRPCHandler reports whether method has the shape every gRPC
+// handler generated for APIV1Service has: func(context.Context, *Request)
+// (*Response, error). reflect.Type.Method includes the receiver as the
+// first parameter, so a handler has 3 "in" entries, not 2.
+func looksLikeRPCHandler(method reflect.Method) bool {
+	fn := method.Func.Type()
+	if fn.NumIn() != 3 || fn.NumOut() != 2 {
+		return false
+	}
+	if fn.In(1) != contextType {
+		return false
+	}
+	if fn.In(2).Kind() != reflect.Ptr {
+		return false
+	}
+	if fn.Out(0).Kind() != reflect.Ptr {
+		return false
+	}
+	return fn.Out(1) == errorType
+}
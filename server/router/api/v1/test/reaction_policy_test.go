@@ -0,0 +1,116 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestUpsertMemoReaction_InteractionPolicy_Denied(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateHostUser(ctx, "owner")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  owner.ID,
+		Content:    "no reactions for you",
+		Visibility: store.Public,
+		Payload: &storepb.MemoPayload{
+			InteractionPolicy: &storepb.InteractionPolicy{
+				CanReact: &storepb.InteractionRule{
+					Always: []string{fmt.Sprintf("users/%d", owner.ID)},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	_, err = ts.Service.UpsertMemoReaction(otherCtx, &v1pb.UpsertMemoReactionRequest{
+		Reaction: &v1pb.Reaction{
+			ContentId:    fmt.Sprintf("memos/%s", memo.UID),
+			ReactionType: "👍",
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUpsertMemoReaction_InteractionPolicy_PendingApproval(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateHostUser(ctx, "owner")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  owner.ID,
+		Content:    "reactions need my ok",
+		Visibility: store.Public,
+		Payload: &storepb.MemoPayload{
+			InteractionPolicy: &storepb.InteractionPolicy{
+				CanReact: &storepb.InteractionRule{
+					WithApproval: []string{"authenticated"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	reaction, err := ts.Service.UpsertMemoReaction(otherCtx, &v1pb.UpsertMemoReactionRequest{
+		Reaction: &v1pb.Reaction{
+			ContentId:    fmt.Sprintf("memos/%s", memo.UID),
+			ReactionType: "👍",
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, reaction)
+
+	// The reactor themselves is not the owner, so the list they'd see
+	// through the owner's eyes should still include the pending reaction.
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	ownerView, err := ts.Service.ListMemoReactions(ownerCtx, &v1pb.ListMemoReactionsRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+	})
+	require.NoError(t, err)
+	require.Len(t, ownerView.Reactions, 1)
+
+	otherView, err := ts.Service.ListMemoReactions(otherCtx, &v1pb.ListMemoReactionsRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+	})
+	require.NoError(t, err)
+	require.Empty(t, otherView.Reactions, "pending reactions should not be visible to non-owners")
+
+	approved, err := ts.Service.ApproveMemoReaction(ownerCtx, &v1pb.ApproveMemoReactionRequest{
+		Name: reaction.Name,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, approved)
+
+	otherView, err = ts.Service.ListMemoReactions(otherCtx, &v1pb.ListMemoReactionsRequest{
+		Name: fmt.Sprintf("memos/%s", memo.UID),
+	})
+	require.NoError(t, err)
+	require.Len(t, otherView.Reactions, 1, "approved reactions become visible to everyone")
+}
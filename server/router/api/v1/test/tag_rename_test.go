@@ -0,0 +1,223 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestRenameUserTag_CascadesToChildrenAndContent(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "tag_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	parent, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-parent",
+		CreatorID:  user.ID,
+		Content:    "top level #work item",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"work"}},
+	})
+	require.NoError(t, err)
+	child, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-child",
+		CreatorID:  user.ID,
+		Content:    "nested #work/sub item, not #workshop though",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"work/sub", "other"}},
+	})
+	require.NoError(t, err)
+
+	resp, err := ts.Service.RenameUserTag(userCtx, &v1pb.RenameUserTagRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Tag:    "work",
+		NewTag: "job",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.MemoCount)
+
+	updatedParent, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &parent.UID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"job"}, updatedParent.Payload.Tags)
+	require.Equal(t, "top level #job item", updatedParent.Content)
+
+	updatedChild, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &child.UID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"job/sub", "other"}, updatedChild.Payload.Tags)
+	require.Equal(t, "nested #job/sub item, not #workshop though", updatedChild.Content,
+		"a longer tag token like #workshop must not be matched by a #work rename")
+}
+
+func TestRenameUserTag_DryRunDoesNotWrite(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "tag_dry_run_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  user.ID,
+		Content:    "about #work",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"work"}},
+	})
+	require.NoError(t, err)
+
+	resp, err := ts.Service.RenameUserTag(userCtx, &v1pb.RenameUserTagRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Tag:    "work",
+		NewTag: "job",
+		DryRun: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), resp.MemoCount)
+	require.Equal(t, []string{fmt.Sprintf("memos/%s", memo.UID)}, resp.MemoNames)
+
+	unchanged, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memo.UID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"work"}, unchanged.Payload.Tags, "dry run must not persist changes")
+	require.Equal(t, "about #work", unchanged.Content)
+}
+
+func TestRenameUserTag_CollisionRejectedWithoutMerge(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "tag_collision_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-work",
+		CreatorID:  user.ID,
+		Content:    "about #work",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"work"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-job",
+		CreatorID:  user.ID,
+		Content:    "about #job",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"job"}},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.RenameUserTag(userCtx, &v1pb.RenameUserTagRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Tag:    "work",
+		NewTag: "job",
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	resp, err := ts.Service.RenameUserTag(userCtx, &v1pb.RenameUserTagRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Tag:    "work",
+		NewTag: "job",
+		Merge:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), resp.MemoCount)
+}
+
+func TestRenameUserTag_MergeDedupsSameMemoCollision(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "tag_merge_dedup_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// This memo already carries both the rename's source and destination
+	// tags, so merging "work" into "job" must collapse them into a single
+	// "job" entry rather than leaving "job" listed twice.
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-both",
+		CreatorID:  user.ID,
+		Content:    "about #work and #job",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"work", "job"}},
+	})
+	require.NoError(t, err)
+
+	resp, err := ts.Service.RenameUserTag(userCtx, &v1pb.RenameUserTagRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Tag:    "work",
+		NewTag: "job",
+		Merge:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), resp.MemoCount)
+
+	updated, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memo.UID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"job"}, updated.Payload.Tags)
+}
+
+func TestMoveTagSubtree_RelocatesWholePrefix(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "tag_subtree_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	root, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-root",
+		CreatorID:  user.ID,
+		Content:    "#archive root",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"archive"}},
+	})
+	require.NoError(t, err)
+	nested, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-nested",
+		CreatorID:  user.ID,
+		Content:    "#archive/q1 report",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"archive/q1"}},
+	})
+	require.NoError(t, err)
+
+	resp, err := ts.Service.MoveTagSubtree(userCtx, &v1pb.MoveTagSubtreeRequest{
+		Parent:    fmt.Sprintf("users/%d", user.ID),
+		OldPrefix: "archive",
+		NewPrefix: "archive/2024",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.MemoCount)
+
+	updatedRoot, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &root.UID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"archive/2024"}, updatedRoot.Payload.Tags)
+
+	updatedNested, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &nested.UID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"archive/2024/q1"}, updatedNested.Payload.Tags)
+	require.Equal(t, "#archive/2024/q1 report", updatedNested.Content)
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// ListUserTags returns the deduplicated set of tags across every memo of
+// parent's user that the caller may read, sorted so a parent tag always
+// precedes its children (plain lexicographic order already does this,
+// since a child tag is its parent plus a "/child" suffix).
+//
+// Unlike the mutating tag RPCs in tag_rename_service.go, this never
+// rejects a caller outright: it narrows the result to whatever memos the
+// Authorizer says they may read, the same visibility gating applied to
+// memo and reaction listing, rather than a separate ad-hoc check.
+func (s *APIV1Service) ListUserTags(ctx context.Context, request *v1pb.ListUserTagsRequest) (*v1pb.ListUserTagsResponse, error) {
+	targetUserID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+	}
+
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &targetUserID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	var (
+		subjectID int32
+		super     bool
+		groups    []string
+	)
+	if user != nil {
+		subjectID = user.ID
+		super = isSuperUser(user)
+		groups, err = s.Store.GroupsOf(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to resolve groups: %v", err)
+		}
+	}
+	visible, err := NewAuthorizer(s.Store).Filter(ctx, subjectID, super, groups, MemoActionRead, memos)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to authorize: %v", err)
+	}
+
+	seen := map[string]struct{}{}
+	for _, memo := range visible {
+		for _, t := range memo.Payload.GetTags() {
+			seen[t] = struct{}{}
+		}
+	}
+	tags := make([]string, 0, len(seen))
+	for t := range seen {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+
+	return &v1pb.ListUserTagsResponse{Tags: tags}, nil
+}
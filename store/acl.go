@@ -0,0 +1,44 @@
+package store
+
+import "context"
+
+// MemoRole is a permission a memo's ACL can grant beyond what its
+// visibility already implies, e.g. letting a specific group react without
+// making the memo fully Public.
+type MemoRole string
+
+const (
+	MemoRoleReader    MemoRole = "reader"
+	MemoRoleCommenter MemoRole = "commenter"
+	MemoRoleReactor   MemoRole = "reactor"
+)
+
+// MemoACLEntry grants role to subject ("users/{id}", "group:{name}",
+// "everyone", or "authenticated") on a single memo.
+type MemoACLEntry struct {
+	MemoID  int32
+	Subject string
+	Role    MemoRole
+}
+
+type FindMemoACL struct {
+	MemoID *int32
+
+	// MemoIDs, when set instead of MemoID, fetches every matching ACL
+	// entry across all of them in a single query (WHERE memo_id IN (...))
+	// so callers like Authorizer.Filter can batch a listing's worth of
+	// memos instead of querying once per memo.
+	MemoIDs []int32
+}
+
+func (s *Store) ListMemoACL(ctx context.Context, find *FindMemoACL) ([]*MemoACLEntry, error) {
+	return s.driver.ListMemoACL(ctx, find)
+}
+
+func (s *Store) UpsertMemoACLEntry(ctx context.Context, entry *MemoACLEntry) error {
+	return s.driver.UpsertMemoACLEntry(ctx, entry)
+}
+
+func (s *Store) DeleteMemoACLEntry(ctx context.Context, entry *MemoACLEntry) error {
+	return s.driver.DeleteMemoACLEntry(ctx, entry)
+}
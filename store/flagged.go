@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+)
+
+// FlaggedItemType is the kind of content a FlaggedItem points at.
+type FlaggedItemType string
+
+const (
+	FlaggedItemTypeMemo     FlaggedItemType = "MEMO"
+	FlaggedItemTypeReaction FlaggedItemType = "REACTION"
+)
+
+// FlaggedItemStatus tracks a flagged row through moderator review.
+type FlaggedItemStatus string
+
+const (
+	// FlaggedItemStatusNeedsReview is the status a row is created with: a
+	// spam filter flagged the content but no moderator has acted on it yet.
+	FlaggedItemStatusNeedsReview FlaggedItemStatus = "NEEDS_REVIEW"
+	// FlaggedItemStatusResolved means a moderator reviewed the item and
+	// chose to keep it; it no longer needs review.
+	FlaggedItemStatusResolved FlaggedItemStatus = "RESOLVED"
+	// FlaggedItemStatusPurged means a moderator reviewed the item and
+	// removed the underlying memo or reaction.
+	FlaggedItemStatusPurged FlaggedItemStatus = "PURGED"
+)
+
+// FlaggedItem is a moderation-queue row created by the spam filter pipeline
+// (internal/filter/spam) when a filter flags a memo or reaction instead of
+// dropping it outright.
+type FlaggedItem struct {
+	ID int32
+
+	ItemType   FlaggedItemType
+	ItemID     int32
+	FilterName string
+	Status     FlaggedItemStatus
+
+	CreatedTs int64
+}
+
+type FindFlaggedItem struct {
+	ID       *int32
+	ItemType *FlaggedItemType
+	ItemID   *int32
+	Status   *FlaggedItemStatus
+}
+
+type UpdateFlaggedItem struct {
+	ID     int32
+	Status FlaggedItemStatus
+}
+
+type DeleteFlaggedItem struct {
+	ID int32
+}
+
+func (s *Store) CreateFlaggedItem(ctx context.Context, create *FlaggedItem) (*FlaggedItem, error) {
+	if create.Status == "" {
+		create.Status = FlaggedItemStatusNeedsReview
+	}
+	return s.driver.CreateFlaggedItem(ctx, create)
+}
+
+func (s *Store) ListFlaggedItems(ctx context.Context, find *FindFlaggedItem) ([]*FlaggedItem, error) {
+	return s.driver.ListFlaggedItems(ctx, find)
+}
+
+func (s *Store) UpdateFlaggedItem(ctx context.Context, update *UpdateFlaggedItem) (*FlaggedItem, error) {
+	return s.driver.UpdateFlaggedItem(ctx, update)
+}
+
+func (s *Store) DeleteFlaggedItem(ctx context.Context, delete *DeleteFlaggedItem) error {
+	return s.driver.DeleteFlaggedItem(ctx, delete)
+}
@@ -0,0 +1,80 @@
+package store
+
+import (
+	"context"
+)
+
+// Group is a named collection of users that can be granted roles on a memo
+// via its ACL, e.g. "group:team-a".
+type Group struct {
+	ID   int32
+	Name string
+
+	CreatorID int32
+	CreatedTs int64
+}
+
+type FindGroup struct {
+	ID   *int32
+	Name *string
+
+	// MemberID, when set, restricts results to groups the given user
+	// belongs to.
+	MemberID *int32
+}
+
+type DeleteGroup struct {
+	ID int32
+}
+
+// GroupMember is a row in the group membership table.
+type GroupMember struct {
+	GroupID int32
+	UserID  int32
+}
+
+func (s *Store) CreateGroup(ctx context.Context, create *Group) (*Group, error) {
+	return s.driver.CreateGroup(ctx, create)
+}
+
+func (s *Store) ListGroups(ctx context.Context, find *FindGroup) ([]*Group, error) {
+	return s.driver.ListGroups(ctx, find)
+}
+
+func (s *Store) GetGroup(ctx context.Context, find *FindGroup) (*Group, error) {
+	groups, err := s.ListGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	return groups[0], nil
+}
+
+func (s *Store) DeleteGroup(ctx context.Context, delete *DeleteGroup) error {
+	return s.driver.DeleteGroup(ctx, delete)
+}
+
+func (s *Store) UpsertGroupMember(ctx context.Context, member *GroupMember) error {
+	return s.driver.UpsertGroupMember(ctx, member)
+}
+
+func (s *Store) DeleteGroupMember(ctx context.Context, member *GroupMember) error {
+	return s.driver.DeleteGroupMember(ctx, member)
+}
+
+// GroupsOf returns the names of every group userID belongs to, e.g.
+// ["group:team-a", "group:team-b"]. It is the building block for Authorizer
+// so that ACL checks can treat group membership as just another principal.
+func (s *Store) GroupsOf(ctx context.Context, userID int32) ([]string, error) {
+	groups, err := s.ListGroups(ctx, &FindGroup{MemberID: &userID})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(groups))
+	for _, group := range groups {
+		names = append(names, "group:"+group.Name)
+	}
+	return names, nil
+}
@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+)
+
+// ReactionApprovalStatus describes whether a reaction is visible to
+// everyone yet or still awaiting the memo owner's decision.
+type ReactionApprovalStatus string
+
+const (
+	// ReactionApprovalStatusAccepted is the default status: the reaction is
+	// visible to anyone who can see the memo.
+	ReactionApprovalStatusAccepted ReactionApprovalStatus = "ACCEPTED"
+	// ReactionApprovalStatusPending means the memo's interaction policy
+	// routed the reaction to the owner for approval; only the owner and the
+	// reactor can see it until it is approved or rejected.
+	ReactionApprovalStatusPending ReactionApprovalStatus = "PENDING"
+)
+
+type Reaction struct {
+	ID int32
+
+	CreatorID      int32
+	ContentID      string
+	ReactionType   string
+	ApprovalStatus ReactionApprovalStatus
+	// NeedsReview is set by the spam filter pipeline (see
+	// internal/filter/spam) when a filter flags the reaction rather than
+	// dropping it outright. A flagged reaction is still stored and still
+	// participates in approval, but stays hidden from everyone except the
+	// memo owner until a moderator clears the FlaggedItem row it created.
+	NeedsReview bool
+
+	CreatedTs int64
+}
+
+type FindReaction struct {
+	ID             *int32
+	CreatorID      *int32
+	ContentID      *string
+	ApprovalStatus *ReactionApprovalStatus
+}
+
+type DeleteReaction struct {
+	ID int32
+}
+
+func (s *Store) UpsertReaction(ctx context.Context, upsert *Reaction) (*Reaction, error) {
+	if upsert.ApprovalStatus == "" {
+		upsert.ApprovalStatus = ReactionApprovalStatusAccepted
+	}
+	return s.driver.UpsertReaction(ctx, upsert)
+}
+
+func (s *Store) ListReactions(ctx context.Context, find *FindReaction) ([]*Reaction, error) {
+	return s.driver.ListReactions(ctx, find)
+}
+
+func (s *Store) GetReaction(ctx context.Context, find *FindReaction) (*Reaction, error) {
+	reactions, err := s.ListReactions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(reactions) == 0 {
+		return nil, nil
+	}
+	return reactions[0], nil
+}
+
+func (s *Store) DeleteReaction(ctx context.Context, delete *DeleteReaction) error {
+	return s.driver.DeleteReaction(ctx, delete)
+}
@@ -0,0 +1,12 @@
+package store
+
+import "context"
+
+// WithTransaction runs fn inside a single database transaction, rolling
+// back every write fn performed if it returns an error. Callers that need
+// to touch several rows atomically (e.g. a cascading tag rename across
+// every affected memo) should wrap the whole operation in one call rather
+// than making several independent Store calls.
+func (s *Store) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.driver.WithTransaction(ctx, fn)
+}